@@ -0,0 +1,276 @@
+package controllers
+
+import (
+	"archive/zip"
+	"blog-platform/database"
+	"blog-platform/events"
+	"blog-platform/mailer"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/responses"
+	"blog-platform/utils"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// accountDeletionGrace silme talebi ile verilerin kalıcı olarak silinmesi
+// arasındaki süredir.
+func accountDeletionGrace() time.Duration {
+	return utils.GetEnvDuration("ACCOUNT_DELETION_GRACE", 14*24*time.Hour)
+}
+
+// RequestAccountDeletion godoc
+// @Summary Hesabı sil
+// @Description Hesabı bekleme süresinin sonunda silinmek üzere işaretler, tüm oturumları ve API token'larını iptal eder. mode=anonymize yazı ve yorumları "deleted" kullanıcısına devreder, mode=delete bunları kalıcı olarak siler. Bekleme süresi içinde tekrar giriş yapılarak talep iptal edilebilir.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body requests.DeleteAccountRequest true "Şifre ve silme modu"
+// @Success 202 {object} responses.AccountDeletionResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz veya şifre hatalı"
+// @Failure 409 {object} responses.ErrorResponse "Silme talebi zaten var"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me [delete]
+func RequestAccountDeletion(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var input requests.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if input.Mode == "" {
+		input.Mode = models.DeletionModeAnonymize
+	}
+
+	if currentUser.DeletionScheduledAt != nil {
+		utils.CreateResponse(c, http.StatusConflict, "Account deletion is already scheduled", nil)
+		return
+	}
+
+	// Yalnızca OIDC ile giriş yapan hesapların şifresi yoktur
+	if currentUser.Password != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(currentUser.Password), []byte(input.Password)); err != nil {
+			utils.CreateResponse(c, http.StatusUnauthorized, "Password is incorrect", nil)
+			return
+		}
+	}
+
+	scheduledAt := time.Now().Add(accountDeletionGrace())
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&currentUser).Updates(map[string]interface{}{
+			"deletion_scheduled_at": scheduledAt,
+			"deletion_mode":         input.Mode,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&models.PersonalAccessToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", currentUser.ID).
+			Update("revoked_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return revokeUserSessions(tx, currentUser.ID)
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not schedule account deletion", nil)
+		return
+	}
+	// Silinmeyi bekleyen yazarların postları beslemelerden çıkar
+	events.PublishContentChanged()
+
+	go func() {
+		err := mailer.Send(mailer.Message{
+			To:      currentUser.Email,
+			Subject: "Your account is scheduled for deletion",
+			Body: fmt.Sprintf("Hi %s,\n\nYour account and personal data will be deleted on %s. If you did not request this, log in before then and cancel the deletion.\n",
+				currentUser.Username, scheduledAt.Format(time.RFC1123)),
+		})
+		if err != nil {
+			log.Printf("Could not send account deletion email: %v", err)
+		}
+	}()
+
+	response := responses.AccountDeletionResponse{
+		ScheduledAt: scheduledAt,
+		Mode:        input.Mode,
+	}
+	utils.CreateResponse(c, http.StatusAccepted, "Account deletion scheduled", response)
+}
+
+// CancelAccountDeletion godoc
+// @Summary Hesap silme talebini iptal et
+// @Description Bekleme süresi dolmamış hesap silme talebini geri alır
+// @Tags User
+// @Produce json
+// @Success 200 {object} responses.MessageResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 404 {object} responses.ErrorResponse "Silme talebi yok"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/deletion/cancel [post]
+func CancelAccountDeletion(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	if currentUser.DeletionScheduledAt == nil {
+		utils.CreateResponse(c, http.StatusNotFound, "No account deletion is scheduled", nil)
+		return
+	}
+
+	err := database.DB.Model(&currentUser).Updates(map[string]interface{}{
+		"deletion_scheduled_at": nil,
+		"deletion_mode":         "",
+	}).Error
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not cancel account deletion", nil)
+		return
+	}
+	events.PublishContentChanged()
+
+	utils.CreateResponse(c, http.StatusOK, "Account deletion cancelled", nil)
+}
+
+// ExportMyData godoc
+// @Summary Kişisel verileri dışa aktar
+// @Description Profil, yazılar, yorumlar, tepkiler ve oturum geçmişini içeren bir arşiv döner. format=zip (varsayılan) her bölüm için ayrı JSON dosyası içeren bir ZIP, format=json tek bir JSON belgesi üretir.
+// @Tags User
+// @Produce json
+// @Produce application/zip
+// @Param format query string false "zip veya json" Enums(zip, json)
+// @Success 200 {object} responses.DataExportResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz format"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/export [get]
+func ExportMyData(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	format := c.DefaultQuery("format", "zip")
+	if format != "zip" && format != "json" {
+		utils.CreateResponse(c, http.StatusBadRequest, "Format must be zip or json", nil)
+		return
+	}
+
+	export, err := buildDataExport(currentUser)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not export data", nil)
+		return
+	}
+
+	fileName := fmt.Sprintf("blog-export-%s-%s", currentUser.Username, export.ExportedAt.Format("20060102"))
+	if format == "json" {
+		c.Header("Content-Disposition", `attachment; filename="`+fileName+`.json"`)
+		c.JSON(http.StatusOK, export)
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", `attachment; filename="`+fileName+`.zip"`)
+	c.Status(http.StatusOK)
+
+	archive := zip.NewWriter(c.Writer)
+	sections := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", export.Profile},
+		{"posts.json", export.Posts},
+		{"comments.json", export.Comments},
+		{"reactions.json", export.Reactions},
+		{"sessions.json", export.Sessions},
+	}
+	for _, section := range sections {
+		writer, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     section.name,
+			Method:   zip.Deflate,
+			Modified: export.ExportedAt,
+		})
+		if err == nil {
+			encoder := json.NewEncoder(writer)
+			encoder.SetIndent("", "  ")
+			err = encoder.Encode(section.data)
+		}
+		if err != nil {
+			// Başlıklar gönderildiği için yalnızca loglanabilir
+			log.Printf("Could not write data export for %s: %v", currentUser.ID, err)
+			return
+		}
+	}
+	if err := archive.Close(); err != nil {
+		log.Printf("Could not write data export for %s: %v", currentUser.ID, err)
+	}
+}
+
+func buildDataExport(user models.User) (responses.DataExportResponse, error) {
+	export := responses.DataExportResponse{
+		ExportedAt: time.Now().UTC(),
+		Profile:    buildProfileResponse(user),
+		Posts:      []responses.PostResponse{},
+		Comments:   []responses.CommentResponse{},
+		Reactions:  []responses.ReactionResponse{},
+		Sessions:   []responses.SessionExport{},
+	}
+
+	var posts []models.Post
+	if err := database.DB.Preload("Categories").Preload("Tags").Where("author_id = ?", user.ID).Order("id").Find(&posts).Error; err != nil {
+		return export, err
+	}
+	for _, post := range posts {
+		export.Posts = append(export.Posts, buildPostResponse(post))
+	}
+
+	var comments []models.Comment
+	if err := database.DB.Where("author_id = ? AND removed_at IS NULL", user.ID).Order("id").Find(&comments).Error; err != nil {
+		return export, err
+	}
+	for _, comment := range comments {
+		export.Comments = append(export.Comments, buildCommentResponse(comment))
+	}
+
+	var reactions []models.Reaction
+	if err := database.DB.Where("user_id = ?", user.ID).Order("id").Find(&reactions).Error; err != nil {
+		return export, err
+	}
+	for _, reaction := range reactions {
+		export.Reactions = append(export.Reactions, buildReactionResponse(reaction))
+	}
+
+	var sessions []models.Session
+	if err := database.DB.Where("user_id = ?", user.ID).Order("created_at").Find(&sessions).Error; err != nil {
+		return export, err
+	}
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, responses.SessionExport{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			RevokedAt:  session.RevokedAt,
+		})
+	}
+
+	return export, nil
+}
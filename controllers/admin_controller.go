@@ -160,3 +160,85 @@ func RemoveRoleFromUser(c *gin.Context) {
 
 	utils.CreateResponse(c, http.StatusOK, "Role removed successfully", nil)
 }
+
+// SetRoleMFARequirement godoc
+// @Summary Require two-factor authentication for a role
+// @Description Users holding a role that requires 2FA must enroll in TOTP before they can use authenticated endpoints
+// @Tags Roles
+// @Accept json
+// @Produce json
+// @Param role_id path int true "Role ID"
+// @Param request body requests.SetRoleMFARequest true "2FA requirement"
+// @Success 200 {object} responses.RoleResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid input"
+// @Failure 404 {object} responses.ErrorResponse "Role not found"
+// @Failure 500 {object} responses.ErrorResponse "Internal server error"
+// @Router /admin/role/{role_id}/mfa [put]
+func SetRoleMFARequirement(c *gin.Context) {
+	roleID, err := strconv.Atoi(c.Param("role_id"))
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid role ID format", nil)
+		return
+	}
+
+	var input requests.SetRoleMFARequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid input", nil)
+		return
+	}
+
+	var role models.Role
+	if err := database.DB.Where("id = ?", roleID).First(&role).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Role not found", nil)
+		return
+	}
+
+	if err := database.DB.Model(&role).Update("require_mfa", *input.RequireMFA).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not update role", nil)
+		return
+	}
+
+	response := responses.RoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		RequireMFA:  role.RequireMFA,
+	}
+	utils.CreateResponse(c, http.StatusOK, "Role updated successfully", response)
+}
+
+// UnlockUser godoc
+// @Summary Unlock a user's login
+// @Description Clears failed login attempts and any temporary lockout for the user's account and records the action in the audit log
+// @Tags Admin
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {object} responses.MessageResponse
+// @Failure 401 {object} responses.ErrorResponse "Unauthorized"
+// @Failure 404 {object} responses.ErrorResponse "User not found"
+// @Router /admin/users/{user_id}/unlock [post]
+func UnlockUser(c *gin.Context) {
+	admin, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	adminUser := admin.(models.User)
+
+	var user models.User
+	if err := database.DB.First(&user, "id = ?", c.Param("user_id")).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "User not found", nil)
+		return
+	}
+
+	resetLoginThrottle(accountThrottleKey(user.Email))
+	writeAuditLog(models.AuditLog{
+		Action:    models.AuditActionLoginUnlock,
+		UserID:    &user.ID,
+		ActorID:   &adminUser.ID,
+		IPAddress: c.ClientIP(),
+		Details:   "login unlocked by an administrator",
+	})
+
+	utils.CreateResponse(c, http.StatusOK, "User unlocked successfully", nil)
+}
@@ -2,70 +2,149 @@ package controllers
 
 import (
 	"blog-platform/database"
+	"blog-platform/events"
 	"blog-platform/models"
 	"blog-platform/requests"
 	"blog-platform/responses"
 	"blog-platform/utils"
+	"errors"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+var (
+	errInvalidCategorySlug = errors.New("Slug must contain at least one letter and may not be purely numeric")
+	errCategorySlugTaken   = errors.New("Slug is already in use")
+	errUnknownParent       = errors.New("Parent category not found")
+	errCategoryCycle       = errors.New("A category cannot be moved under itself or one of its descendants")
+	errMergeIntoSelf       = errors.New("A category cannot be merged into itself")
+	errMergeIntoDescendant = errors.New("A category cannot be merged into one of its descendants")
+	errMergeTargetNotFound = errors.New("Target category not found")
+	errCategoryNotFound    = errors.New("Category not found")
 )
 
 // GetCategories godoc
-// @Summary Retrieve all categories
-// @Description Get a list of all categories
+// @Summary Retrieve categories
+// @Description Get a paginated list of categories sorted by name, each with its breadcrumb
 // @Tags Categories
 // @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default 20, max 100)"
+// @Param cursor query string false "meta.next_cursor from the previous response"
 // @Success 200 {object} []responses.CategoryResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid pagination parameters"
 // @Failure 500 {object} responses.ErrorResponse "Could not retrieve categories"
 // @Router /category [get]
 func GetCategories(c *gin.Context) {
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	sortKey := utils.SortKey{Expr: "categories.name"}
+	categories, meta, err := utils.Paginate(c, pagination, database.DB.Model(&models.Category{}), sortKey, "categories.id",
+		func(category models.Category) (interface{}, uint) { return category.Name, category.ID })
+	if err != nil {
+		respondListError(c, err, "Could not retrieve categories")
+		return
+	}
+
+	index, err := loadCategoryIndex(database.DB)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve categories", nil)
+		return
+	}
+
+	responseCategories := make([]responses.CategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		responseCategories = append(responseCategories, buildCategoryDetailResponse(category, index))
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Categories retrieved successfully.", responseCategories, meta)
+}
+
+// GetCategoryTree godoc
+// @Summary Retrieve the category tree
+// @Description Get every category nested under its parent; siblings are sorted by name
+// @Tags Categories
+// @Produce json
+// @Success 200 {object} []responses.CategoryTreeNode
+// @Failure 500 {object} responses.ErrorResponse "Could not retrieve categories"
+// @Router /category/tree [get]
+func GetCategoryTree(c *gin.Context) {
 	var categories []models.Category
-	if err := database.DB.Find(&categories).Error; err != nil {
+	if err := database.DB.Order("name, id").Find(&categories).Error; err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve categories", nil)
 		return
 	}
 
-	var responseCategories []responses.CategoryResponse
+	known := make(map[uint]bool, len(categories))
 	for _, category := range categories {
-		responseCategories = append(responseCategories, responses.CategoryResponse{
-			ID:   category.ID,
-			Name: category.Name,
-		})
+		known[category.ID] = true
+	}
+	children := map[uint][]models.Category{}
+	for _, category := range categories {
+		parent := uint(0)
+		if category.ParentID != nil && known[*category.ParentID] {
+			parent = *category.ParentID
+		}
+		children[parent] = append(children[parent], category)
+	}
+
+	var build func(parent uint) []responses.CategoryTreeNode
+	build = func(parent uint) []responses.CategoryTreeNode {
+		nodes := make([]responses.CategoryTreeNode, 0, len(children[parent]))
+		for _, category := range children[parent] {
+			nodes = append(nodes, responses.CategoryTreeNode{
+				ID:          category.ID,
+				Name:        category.Name,
+				Slug:        category.Slug,
+				Description: category.Description,
+				Children:    build(category.ID),
+			})
+		}
+		return nodes
 	}
 
-	utils.CreateResponse(c, http.StatusOK, "Categories retrieved successfully.", responseCategories)
+	utils.CreateResponse(c, http.StatusOK, "Category tree retrieved successfully", build(0))
 }
 
 // GetCategory godoc
 // @Summary Retrieve a single category
-// @Description Get a category by its ID
+// @Description Get a category by its ID or slug, including its breadcrumb
 // @Tags Categories
 // @Produce json
-// @Param category_id path int true "Category ID"
+// @Param category_id path string true "Category ID or slug"
 // @Success 200 {object} responses.CategoryResponse
 // @Failure 404 {object} responses.ErrorResponse "Category not found"
 // @Router /category/{category_id} [get]
 func GetCategory(c *gin.Context) {
-	categoryID := c.Param("category_id")
-
-	var category models.Category
-	if err := database.DB.Where("id = ?", categoryID).First(&category).Error; err != nil {
+	category, err := findCategoryByParam(c.Param("category_id"))
+	if err != nil {
 		utils.CreateResponse(c, http.StatusNotFound, "Category not found", nil)
 		return
 	}
 
-	response := responses.CategoryResponse{
-		ID:   category.ID,
-		Name: category.Name,
+	index, err := loadCategoryIndex(database.DB)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve category", nil)
+		return
 	}
 
+	response := buildCategoryDetailResponse(category, index)
+
 	utils.CreateResponse(c, http.StatusOK, "Category retrieved successfully", response)
 }
 
 // CreateCategory godoc
 // @Summary Create a new category
-// @Description Create a new category with a given name
+// @Description Create a new category, optionally nested under a parent. The slug is derived from the name unless one is given. Requires the Editor or Admin role.
 // @Tags Categories
 // @Accept json
 // @Produce json
@@ -73,6 +152,8 @@ func GetCategory(c *gin.Context) {
 // @Success 200 {object} responses.CategoryResponse
 // @Failure 400 {object} responses.ErrorResponse "Invalid input"
 // @Failure 401 {object} responses.ErrorResponse "Unauthorized"
+// @Failure 403 {object} responses.ErrorResponse "Forbidden"
+// @Failure 409 {object} responses.ErrorResponse "Slug is already in use"
 // @Failure 500 {object} responses.ErrorResponse "Could not create category"
 // @Router /category [post]
 func CreateCategory(c *gin.Context) {
@@ -89,17 +170,478 @@ func CreateCategory(c *gin.Context) {
 	}
 
 	category := models.Category{
-		Name:      input.Name,
-		CreatedBy: user.(models.User).ID,
+		Name:        strings.TrimSpace(input.Name),
+		Description: strings.TrimSpace(input.Description),
+		ParentID:    input.ParentID,
+		CreatedBy:   user.(models.User).ID,
 	}
 
-	if err := database.DB.Create(&category).Error; err != nil {
-		utils.CreateResponse(c, http.StatusInternalServerError, "Could not create category", nil)
+	var index categoryIndex
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if index, err = loadCategoryIndex(tx); err != nil {
+			return err
+		}
+		if err := validateCategoryParent(index, 0, category.ParentID); err != nil {
+			return err
+		}
+		if category.Slug, err = resolveCategorySlug(tx, input.Slug, category.Name, 0); err != nil {
+			return err
+		}
+		return tx.Create(&category).Error
+	})
+	if err != nil {
+		respondCategoryWriteError(c, err, "Could not create category")
 		return
 	}
-	response := responses.CategoryResponse{
-		ID:   category.ID,
-		Name: category.Name,
-	}
+
+	index[category.ID] = category
+	response := buildCategoryDetailResponse(category, index)
 	utils.CreateResponse(c, http.StatusOK, "Category created successfully", response)
 }
+
+// UpdateCategory godoc
+// @Summary Update a category
+// @Description Replace a category's name, description and parent. Omitting parent_id moves the category to the root; omitting slug keeps the current one. Requires the Editor or Admin role.
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param category_id path int true "Category ID"
+// @Param category body requests.UpdateCategoryRequest true "Category information"
+// @Success 200 {object} responses.CategoryResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid input"
+// @Failure 403 {object} responses.ErrorResponse "Forbidden"
+// @Failure 404 {object} responses.ErrorResponse "Category not found"
+// @Failure 409 {object} responses.ErrorResponse "Slug is already in use"
+// @Failure 500 {object} responses.ErrorResponse "Could not update category"
+// @Router /category/{category_id} [put]
+func UpdateCategory(c *gin.Context) {
+	categoryID, err := strconv.ParseUint(c.Param("category_id"), 10, 64)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid category ID", nil)
+		return
+	}
+
+	var input requests.UpdateCategoryRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var category models.Category
+	var index categoryIndex
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&category, categoryID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errCategoryNotFound
+			}
+			return err
+		}
+
+		var err error
+		if index, err = loadCategoryIndex(tx); err != nil {
+			return err
+		}
+		if err := validateCategoryParent(index, category.ID, input.ParentID); err != nil {
+			return err
+		}
+
+		name, slug := category.Name, category.Slug
+		category.Name = strings.TrimSpace(input.Name)
+		category.Description = strings.TrimSpace(input.Description)
+		category.ParentID = input.ParentID
+		if input.Slug != "" {
+			if category.Slug, err = resolveCategorySlug(tx, input.Slug, category.Name, category.ID); err != nil {
+				return err
+			}
+		}
+		// Postların yanıtlarında kategorinin adı ve slug'ı yer alır
+		if category.Name != name || category.Slug != slug {
+			if err := bumpCategoryPostVersions(tx, category.ID); err != nil {
+				return err
+			}
+		}
+		return tx.Save(&category).Error
+	})
+	if err != nil {
+		respondCategoryWriteError(c, err, "Could not update category")
+		return
+	}
+
+	events.PublishContentChanged()
+
+	index[category.ID] = category
+	response := buildCategoryDetailResponse(category, index)
+	utils.CreateResponse(c, http.StatusOK, "Category updated successfully", response)
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description Delete a category. Its posts stay published but lose this category, and its child categories move up to the deleted category's parent. Requires the Editor or Admin role.
+// @Tags Categories
+// @Produce json
+// @Param category_id path int true "Category ID"
+// @Success 200 {object} responses.MessageResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid category ID"
+// @Failure 403 {object} responses.ErrorResponse "Forbidden"
+// @Failure 404 {object} responses.ErrorResponse "Category not found"
+// @Failure 500 {object} responses.ErrorResponse "Could not delete category"
+// @Router /category/{category_id} [delete]
+func DeleteCategory(c *gin.Context) {
+	categoryID, err := strconv.ParseUint(c.Param("category_id"), 10, 64)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid category ID", nil)
+		return
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var category models.Category
+		if err := tx.First(&category, categoryID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errCategoryNotFound
+			}
+			return err
+		}
+
+		if err := tx.Model(&models.Category{}).Where("parent_id = ?", category.ID).Update("parent_id", category.ParentID).Error; err != nil {
+			return err
+		}
+		if err := bumpCategoryPostVersions(tx, category.ID); err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM post_categories WHERE category_id = ?", category.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&category).Error
+	})
+	if err != nil {
+		respondCategoryWriteError(c, err, "Could not delete category")
+		return
+	}
+
+	events.PublishContentChanged()
+	utils.CreateResponse(c, http.StatusOK, "Category deleted successfully", nil)
+}
+
+// MergeCategory godoc
+// @Summary Merge a category into another one
+// @Description Move every post and child category of the source category to the target category, then delete the source. Requires the Editor or Admin role.
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param category_id path int true "Source category ID"
+// @Param merge body requests.MergeCategoryRequest true "Target category"
+// @Success 200 {object} responses.CategoryMergeResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid input"
+// @Failure 403 {object} responses.ErrorResponse "Forbidden"
+// @Failure 404 {object} responses.ErrorResponse "Category not found"
+// @Failure 500 {object} responses.ErrorResponse "Could not merge categories"
+// @Router /category/{category_id}/merge [post]
+func MergeCategory(c *gin.Context) {
+	categoryID, err := strconv.ParseUint(c.Param("category_id"), 10, 64)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid category ID", nil)
+		return
+	}
+
+	var input requests.MergeCategoryRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if uint(categoryID) == input.TargetID {
+		utils.CreateResponse(c, http.StatusBadRequest, errMergeIntoSelf.Error(), nil)
+		return
+	}
+
+	var target models.Category
+	var index categoryIndex
+	var movedPosts int64
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var source models.Category
+		if err := tx.First(&source, categoryID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errCategoryNotFound
+			}
+			return err
+		}
+		if err := tx.First(&target, input.TargetID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errMergeTargetNotFound
+			}
+			return err
+		}
+
+		var err error
+		if index, err = loadCategoryIndex(tx); err != nil {
+			return err
+		}
+		if index.isDescendant(target.ID, source.ID) {
+			return errMergeIntoDescendant
+		}
+
+		if err := bumpCategoryPostVersions(tx, source.ID); err != nil {
+			return err
+		}
+		// Hedefte zaten bulunan postlar için join satırı tekrarlanmaz
+		result := tx.Exec(`INSERT INTO post_categories (post_id, category_id)
+			SELECT post_id, ? FROM post_categories
+			WHERE category_id = ? AND post_id NOT IN (SELECT post_id FROM post_categories WHERE category_id = ?)`,
+			target.ID, source.ID, target.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		movedPosts = result.RowsAffected
+
+		if err := tx.Exec("DELETE FROM post_categories WHERE category_id = ?", source.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Category{}).Where("parent_id = ?", source.ID).Update("parent_id", target.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&source).Error
+	})
+	if err != nil {
+		respondCategoryWriteError(c, err, "Could not merge categories")
+		return
+	}
+
+	events.PublishContentChanged()
+
+	response := responses.CategoryMergeResponse{
+		Target:     buildCategoryDetailResponse(target, index),
+		MovedPosts: movedPosts,
+	}
+	utils.CreateResponse(c, http.StatusOK, "Categories merged successfully", response)
+}
+
+// GetCategoryPosts godoc
+// @Summary Retrieve posts in a category
+// @Description Get a paginated list of posts assigned to a category; accepts the same filters and sort options as GET /posts
+// @Tags Categories
+// @Produce json
+// @Param category_id path string true "Category ID or slug"
+// @Param include_descendants query bool false "Also include posts of child categories"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default 20, max 100)"
+// @Param cursor query string false "meta.next_cursor from the previous response"
+// @Param sort query string false "Sort order" Enums(newest, oldest, most_reacted, most_commented)
+// @Success 200 {object} responses.PostsResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Category not found"
+// @Failure 500 {object} responses.ErrorResponse "Could not retrieve posts"
+// @Router /category/{category_id}/posts [get]
+func GetCategoryPosts(c *gin.Context) {
+	category, err := findCategoryByParam(c.Param("category_id"))
+	if err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Category not found", nil)
+		return
+	}
+
+	categoryIDs := []uint{category.ID}
+	if c.Query("include_descendants") == "true" {
+		index, err := loadCategoryIndex(database.DB)
+		if err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve posts", nil)
+			return
+		}
+		categoryIDs = append(categoryIDs, index.descendants(category.ID)...)
+	}
+
+	listPosts(c, database.DB.Model(&models.Post{}).Where("posts.id IN (SELECT post_id FROM post_categories WHERE category_id IN ?)", categoryIDs), false)
+}
+
+// categoryIndex breadcrumb ve hiyerarşi kontrolleri için tüm kategorileri ID ile tutar.
+type categoryIndex map[uint]models.Category
+
+func loadCategoryIndex(tx *gorm.DB) (categoryIndex, error) {
+	var categories []models.Category
+	if err := tx.Select("id", "name", "slug", "parent_id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	index := make(categoryIndex, len(categories))
+	for _, category := range categories {
+		index[category.ID] = category
+	}
+	return index, nil
+}
+
+// path kök kategoriden verilen kategoriye kadar olan zinciri döner.
+func (index categoryIndex) path(id uint) []models.Category {
+	var chain []models.Category
+	seen := map[uint]bool{}
+	for current, ok := index[id]; ok && !seen[current.ID]; current, ok = index[derefUint(current.ParentID)] {
+		seen[current.ID] = true
+		chain = append([]models.Category{current}, chain...)
+	}
+	return chain
+}
+
+// isDescendant id'nin ancestorID'nin altında (veya kendisi) olup olmadığını döner.
+func (index categoryIndex) isDescendant(id, ancestorID uint) bool {
+	for _, category := range index.path(id) {
+		if category.ID == ancestorID {
+			return true
+		}
+	}
+	return false
+}
+
+// descendants verilen kategorinin tüm alt kategorilerinin ID'lerini döner.
+func (index categoryIndex) descendants(id uint) []uint {
+	var ids []uint
+	for candidate := range index {
+		if candidate != id && index.isDescendant(candidate, id) {
+			ids = append(ids, candidate)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func derefUint(value *uint) uint {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+// validateCategoryParent yeni üst kategorinin var olduğunu ve kategoriyi kendi
+// altına taşımadığını kontrol eder. categoryID yeni kategoriler için 0'dır.
+func validateCategoryParent(index categoryIndex, categoryID uint, parentID *uint) error {
+	if parentID == nil {
+		return nil
+	}
+	if _, ok := index[*parentID]; !ok {
+		return errUnknownParent
+	}
+	if categoryID != 0 && index.isDescendant(*parentID, categoryID) {
+		return errCategoryCycle
+	}
+	return nil
+}
+
+// resolveCategorySlug açıkça istenen slug'ı doğrular ve çakışmada hata döner;
+// slug verilmemişse addan benzersiz bir slug üretir. Yalnızca rakamlardan oluşan
+// slug'lar ID'lerle karışacağı için kabul edilmez.
+func resolveCategorySlug(tx *gorm.DB, requested, name string, excludeID uint) (string, error) {
+	if requested != "" {
+		slug := utils.Slugify(requested)
+		if slug == "" || isNumeric(slug) {
+			return "", errInvalidCategorySlug
+		}
+
+		var count int64
+		if err := tx.Model(&models.Category{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count > 0 {
+			return "", errCategorySlugTaken
+		}
+		return slug, nil
+	}
+
+	return utils.UniqueSlug(tx, &models.Category{}, utils.SlugWithFallback(name, "category"), excludeID)
+}
+
+func isNumeric(value string) bool {
+	_, err := strconv.ParseUint(value, 10, 64)
+	return err == nil
+}
+
+// findCategoryByParam yol parametresini sayısal ise ID, değilse slug olarak arar.
+func findCategoryByParam(param string) (models.Category, error) {
+	var category models.Category
+	if isNumeric(param) {
+		return category, database.DB.First(&category, "id = ?", param).Error
+	}
+	return category, database.DB.First(&category, "slug = ?", param).Error
+}
+
+// respondCategoryWriteError kategori yazma işlemlerindeki hataları uygun durum koduna çevirir.
+func respondCategoryWriteError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, errCategoryNotFound), errors.Is(err, errMergeTargetNotFound):
+		utils.CreateResponse(c, http.StatusNotFound, err.Error(), nil)
+	case errors.Is(err, errInvalidCategorySlug), errors.Is(err, errUnknownParent),
+		errors.Is(err, errCategoryCycle), errors.Is(err, errMergeIntoDescendant):
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+	case errors.Is(err, errCategorySlugTaken), errors.Is(err, gorm.ErrDuplicatedKey):
+		utils.CreateResponse(c, http.StatusConflict, errCategorySlugTaken.Error(), nil)
+	default:
+		utils.CreateResponse(c, http.StatusInternalServerError, fallback, nil)
+	}
+}
+
+// findCategories verilen ID'lerdeki kategorileri döner; bulunamayan bir ID
+// varsa unknownCategoriesError döner.
+func findCategories(ids []uint) ([]models.Category, error) {
+	if len(ids) == 0 {
+		return []models.Category{}, nil
+	}
+
+	var categories []models.Category
+	if err := database.DB.Where("id IN ?", ids).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	found := make(map[uint]bool, len(categories))
+	for _, category := range categories {
+		found[category.ID] = true
+	}
+	var missing []string
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, strconv.FormatUint(uint64(id), 10))
+			found[id] = true // Tekrarlanan ID'leri bir kez raporla
+		}
+	}
+	if len(missing) > 0 {
+		return nil, unknownCategoriesError(missing)
+	}
+	return categories, nil
+}
+
+type unknownCategoriesError []string
+
+func (e unknownCategoriesError) Error() string {
+	return "Unknown category IDs: " + strings.Join(e, ", ")
+}
+
+// respondCategoryError bilinmeyen kategoriler için 400, diğer hatalar için 500 döner.
+func respondCategoryError(c *gin.Context, err error) {
+	var unknown unknownCategoriesError
+	if errors.As(err, &unknown) {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.CreateResponse(c, http.StatusInternalServerError, "Could not load categories", nil)
+}
+
+func buildCategoryResponse(category models.Category) responses.CategoryResponse {
+	return responses.CategoryResponse{
+		ID:          category.ID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		ParentID:    category.ParentID,
+	}
+}
+
+// buildCategoryDetailResponse kategori yanıtına kökten başlayan breadcrumb'ı ekler.
+func buildCategoryDetailResponse(category models.Category, index categoryIndex) responses.CategoryResponse {
+	response := buildCategoryResponse(category)
+	for _, step := range index.path(category.ID) {
+		response.Breadcrumb = append(response.Breadcrumb, responses.CategoryCrumb{ID: step.ID, Name: step.Name, Slug: step.Slug})
+	}
+	return response
+}
+
+// bumpCategoryPostVersions kategorisi değişen postların sürümünü artırır; böylece
+// önbellekteki ETag'ler geçersizleşir.
+func bumpCategoryPostVersions(tx *gorm.DB, categoryID uint) error {
+	return tx.Model(&models.Post{}).
+		Where("id IN (SELECT post_id FROM post_categories WHERE category_id = ?)", categoryID).
+		UpdateColumn("version", bumpVersion).Error
+}
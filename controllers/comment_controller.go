@@ -5,20 +5,32 @@ import (
 	"blog-platform/models"
 	"blog-platform/requests"
 	"blog-platform/responses"
+	"blog-platform/search"
+	"blog-platform/spam"
 	"blog-platform/utils"
+	"errors"
+	"log"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // GetCommentsByPost godoc
 // @Summary Belirli bir posta ait yorumları getir
-// @Description Post ID'ye göre yorumları getirir
+// @Description Post ID'ye göre üst düzey yorumları (thread) sayfalı olarak getirir. Her yorumun altında en eski yanıtlar iç içe gömülü gelir; replies parametresi her seviyede kaç yanıt gömüleceğini belirler. Gömülmeyen yanıtlar has_more_replies ile belirtilir ve GET /comments/{comment_id}/replies ile alınır.
 // @Tags Comment
 // @Produce json
 // @Param post_id path int true "Post ID"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına thread (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param sort query string false "Thread sıralaması (varsayılan oldest)" Enums(newest, oldest)
+// @Param replies query int false "Her yorumun altına gömülecek yanıt sayısı (varsayılan 3, en fazla 20)"
 // @Success 200 {object} responses.CommentsResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
 // @Failure 404 {object} responses.ErrorResponse "Post bulunamadı"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /comments/post/{post_id} [get]
@@ -26,37 +38,84 @@ func GetCommentsByPost(c *gin.Context) {
 	postID := c.Param("post_id")
 
 	var post models.Post
-	if err := database.DB.Where("id = ?", postID).First(&post).Error; err != nil {
+	if err := database.DB.Where("id = ?", postID).First(&post).Error; err != nil || !canViewPost(post, optionalUser(c)) {
 		utils.CreateResponse(c, http.StatusNotFound, "Post Not Found", nil)
 		return
 	}
 
-	var comments []models.Comment
-	if err := database.DB.Where("post_id = ?", postID).Find(&comments).Error; err != nil {
-		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve post comments", nil)
+	query := database.DB.Model(&models.Comment{}).Where("post_id = ? AND parent_id IS NULL AND status = ?", postID, models.CommentStatusApproved)
+	listCommentThreads(c, query, "oldest", "Comments retrieved successfully", "Could not retrieve post comments")
+}
+
+// GetCommentReplies godoc
+// @Summary Bir yorumun yanıtlarını getir
+// @Description Yorumun doğrudan yanıtlarını en eskiden başlayarak sayfalı olarak getirir ("daha fazla yanıt yükle"). Her yanıtın altında kendi yanıtları GetCommentsByPost'taki gibi gömülü gelir.
+// @Tags Comment
+// @Produce json
+// @Param comment_id path int true "Comment ID"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına yanıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param sort query string false "Sıralama (varsayılan oldest)" Enums(newest, oldest)
+// @Param replies query int false "Her yanıtın altına gömülecek yanıt sayısı (varsayılan 3, en fazla 20)"
+// @Success 200 {object} responses.CommentsResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
+// @Failure 404 {object} responses.ErrorResponse "Yorum bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /comments/{comment_id}/replies [get]
+func GetCommentReplies(c *gin.Context) {
+	var comment models.Comment
+	if err := database.DB.Preload("Post").Where("id = ? AND status = ?", c.Param("comment_id"), models.CommentStatusApproved).First(&comment).Error; err != nil || !canViewPost(comment.Post, optionalUser(c)) {
+		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
 		return
 	}
 
-	var responseComments []responses.CommentResponse
-	for _, comment := range comments {
-		responseComments = append(responseComments, responses.CommentResponse{
-			ID:        comment.ID,
-			Content:   comment.Content,
-			AuthorID:  comment.AuthorID,
-			PostID:    comment.PostID,
-			CreatedAt: comment.CreatedAt,
-			UpdatedAt: comment.UpdatedAt,
-		})
+	query := database.DB.Model(&models.Comment{}).Where("parent_id = ? AND status = ?", comment.ID, models.CommentStatusApproved)
+	listCommentThreads(c, query, "oldest", "Replies retrieved successfully", "Could not retrieve replies")
+}
+
+// listCommentThreads sorgudaki yorumları sayfalar ve yanıtlarını gömerek döner.
+func listCommentThreads(c *gin.Context, query *gorm.DB, defaultSort, message, failure string) {
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	sortKey, err := timeSortKey(c, "comments", defaultSort)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	repliesLimit, err := parseRepliesLimit(c.Query("replies"))
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	comments, meta, err := utils.Paginate(c, pagination, query, sortKey, "comments.id", commentCursor)
+	if err != nil {
+		respondListError(c, err, failure)
+		return
 	}
 
-	utils.CreateResponse(c, http.StatusOK, "Comments retrieved successfully", responses.CommentsResponse{Comments: responseComments})
+	threads, err := buildCommentThreads(comments, repliesLimit)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, failure, nil)
+		return
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, message, responses.CommentsResponse{Comments: threads}, meta)
 }
 
 // GetCommentsByUser godoc
 // @Summary Kullanıcıya ait yorumları getir
-// @Description Giriş yapmış kullanıcıya ait tüm yorumları getirir
+// @Description Giriş yapmış kullanıcıya ait yorumları sayfalı olarak getirir
 // @Tags Comment
 // @Produce json
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param sort query string false "Sıralama (varsayılan newest)" Enums(newest, oldest)
 // @Success 200 {object} responses.CommentsResponse
 // @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
@@ -69,25 +128,32 @@ func GetCommentsByUser(c *gin.Context) {
 	}
 
 	currentUser := user.(models.User)
-	var comments []models.Comment
-	if err := database.DB.Where("author_id = ?", currentUser.ID).Find(&comments).Error; err != nil {
-		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve user comments", nil)
+
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	sortKey, err := timeSortKey(c, "comments", "newest")
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 
-	var responseComments []responses.CommentResponse
-	for _, comment := range comments {
-		responseComments = append(responseComments, responses.CommentResponse{
-			ID:        comment.ID,
-			Content:   comment.Content,
-			AuthorID:  comment.AuthorID,
-			PostID:    comment.PostID,
-			CreatedAt: comment.CreatedAt,
-			UpdatedAt: comment.UpdatedAt,
-		})
+	query := database.DB.Model(&models.Comment{}).Where("author_id = ? AND removed_at IS NULL", currentUser.ID)
+	comments, meta, err := utils.Paginate(c, pagination, query, sortKey, "comments.id", commentCursor)
+	if err != nil {
+		respondListError(c, err, "Could not retrieve user comments")
+		return
+	}
+
+	responseComments, err := buildCommentThreads(comments, 0)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve user comments", nil)
+		return
 	}
 
-	utils.CreateResponse(c, http.StatusOK, "User comments retrieved successfully", responses.CommentsResponse{Comments: responseComments})
+	utils.CreatePaginatedResponse(c, http.StatusOK, "User comments retrieved successfully", responses.CommentsResponse{Comments: responseComments}, meta)
 }
 
 // CreateComment godoc
@@ -99,8 +165,12 @@ func GetCommentsByUser(c *gin.Context) {
 // @Param post_id path int true "Post ID"
 // @Param comment body requests.CreateCommentRequest true "Yorum bilgisi"
 // @Success 200 {object} responses.CommentResponse
+// @Success 202 {object} responses.CommentResponse "Yorum onay bekliyor"
 // @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
 // @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 403 {object} responses.ErrorResponse "Yorumlar kapalı"
+// @Failure 404 {object} responses.ErrorResponse "Post bulunamadı veya yayımlanmamış"
+// @Failure 422 {object} responses.ErrorResponse "Yorum spam olarak reddedildi"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /comments/{post_id} [post]
 func CreateComment(c *gin.Context) {
@@ -122,41 +192,168 @@ func CreateComment(c *gin.Context) {
 		return
 	}
 
+	// Yalnızca yayımlanmış postlara yorum yapılabilir
+	post, err := findPublishedPost(postID)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Post Not Found", nil)
+		return
+	}
+
+	comment := models.Comment{PostID: uint(postID)}
+	saveNewComment(c, post, &comment, input, user.(models.User), "Comment created successfully")
+}
+
+// ReplyToComment godoc
+// @Summary Bir yoruma yanıt ver
+// @Description Yorumun altına iç içe bir yanıt ekler. Yanıtlar en fazla COMMENT_MAX_DEPTH seviye (varsayılan 5) iç içe olabilir; daha derine yanıt verilemez.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param comment_id path int true "Yanıt verilen yorumun ID'si"
+// @Param comment body requests.CreateCommentRequest true "Yanıt bilgisi"
+// @Success 200 {object} responses.CommentResponse
+// @Success 202 {object} responses.CommentResponse "Yorum onay bekliyor"
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri veya en fazla derinliğe ulaşıldı"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 403 {object} responses.ErrorResponse "Yorumlar kapalı"
+// @Failure 404 {object} responses.ErrorResponse "Yorum bulunamadı, silinmiş veya post yayımlanmamış"
+// @Failure 422 {object} responses.ErrorResponse "Yorum spam olarak reddedildi"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /comments/{comment_id}/reply [post]
+func ReplyToComment(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	var input requests.CreateCommentRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	commentID, err := strconv.ParseUint(c.Param("comment_id"), 10, 32)
+	if err != nil || commentID == 0 {
+		utils.CreateResponse(c, http.StatusBadRequest, "Valid Comment ID is required", nil)
+		return
+	}
+
+	// Silinmiş veya onaylanmamış yorumlara ve yayımdan kaldırılmış postlardaki yorumlara yanıt verilemez
+	var parent models.Comment
+	if err := database.DB.Where("id = ? AND removed_at IS NULL AND status = ?", uint(commentID), models.CommentStatusApproved).First(&parent).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
+		return
+	}
+	post, err := findPublishedPost(parent.PostID)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
+		return
+	}
+	if parent.Depth >= commentMaxDepth() {
+		utils.CreateResponse(c, http.StatusBadRequest, "Maximum reply depth reached", nil)
+		return
+	}
+
 	comment := models.Comment{
-		Content:  input.Content,
-		AuthorID: user.(models.User).ID,
-		PostID:   uint(postID),
+		PostID:   parent.PostID,
+		ParentID: &parent.ID,
+		Depth:    parent.Depth + 1,
 	}
+	saveNewComment(c, post, &comment, input, user.(models.User), "Reply created successfully")
+}
 
-	if err := database.DB.Create(&comment).Error; err != nil {
+// saveNewComment yorumu veya yanıtı postun yorum ayarına göre yayımlar ya da
+// onaya bırakır, indeksler ve ilk revizyonunu oluşturur.
+func saveNewComment(c *gin.Context, post models.Post, comment *models.Comment, input requests.CreateCommentRequest, author models.User, message string) {
+	if commentModeFor(post) == models.CommentModeClosed && !canModerateComments(post, author) {
+		utils.CreateResponse(c, http.StatusForbidden, "Comments are closed on this post", nil)
+		return
+	}
+	status, reason, err := initialCommentStatus(post, author)
+	if err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not create comment", nil)
 		return
 	}
 
-	responseComment := responses.CommentResponse{
-		ID:        comment.ID,
-		Content:   comment.Content,
-		AuthorID:  comment.AuthorID,
-		PostID:    comment.PostID,
-		CreatedAt: comment.CreatedAt,
-		UpdatedAt: comment.UpdatedAt,
+	// Yazının sahibi ve editörler spam kontrolünden geçmez
+	if !canModerateComments(post, author) {
+		result, err := spam.Evaluate(c.Request.Context(), spam.Submission{
+			Kind:      spam.KindComment,
+			UserID:    &author.ID,
+			IP:        c.ClientIP(),
+			Text:      input.Content,
+			Honeypot:  input.Honeypot,
+			FormToken: input.FormToken,
+		})
+		if err != nil {
+			log.Printf("Spam check failed for comment by %s: %v", author.ID, err)
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not create comment", nil)
+			return
+		}
+		switch result.Action {
+		case spam.ActionReject:
+			utils.CreateResponse(c, http.StatusUnprocessableEntity, "Comment was rejected as spam", nil)
+			return
+		case spam.ActionModerate:
+			status, reason = models.CommentStatusPending, "Possible spam: "+result.Reason()
+		}
+	}
+
+	comment.Status = status
+	comment.Content = input.Content
+	comment.ContentFormat = contentFormatOrDefault(input.ContentFormat)
+	comment.AuthorID = author.ID
+	if err := renderCommentContent(comment); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not render comment content", nil)
+		return
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return err
+		}
+		if err := search.IndexComment(tx, *comment); err != nil {
+			return err
+		}
+		if status == models.CommentStatusPending {
+			if err := recordCommentModeration(tx, comment.ID, "", status, models.SystemActorID, reason); err != nil {
+				return err
+			}
+		}
+		return recordCommentRevision(tx, *comment, author, "")
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not create comment", nil)
+		return
+	}
+
+	if status == models.CommentStatusPending {
+		utils.CreateResponse(c, http.StatusAccepted, "Comment is awaiting moderation", buildCommentResponse(*comment))
+		return
 	}
-	utils.CreateResponse(c, http.StatusOK, "Comment created successfully", responseComment)
+	utils.CreateResponse(c, http.StatusOK, message, buildCommentResponse(*comment))
 }
 
 // UpdateComment godoc
 // @Summary Mevcut bir yorumu güncelle
-// @Description Belirli bir yorumu günceller
+// @Description Belirli bir yorumu günceller. İstemci gördüğü sürümü If-Match başlığıyla (veya version alanıyla) göndermelidir; yorum bu arada değiştiyse 412 ile güncel hali döner.
 // @Tags Comment
 // @Accept json
 // @Produce json
 // @Param comment_id path int true "Comment ID"
+// @Param If-Match header string false "Yorumun ETag değeri, ör. \"3\""
 // @Param comment body requests.UpdateCommentRequest true "Yorum bilgisi"
 // @Success 200 {object} responses.CommentResponse
+// @Header 200 {string} ETag "Yorumun yeni sürümü"
+// @Success 202 {object} responses.CommentResponse "Düzenleme spam şüphesiyle yeniden onaya gönderildi"
 // @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
 // @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
 // @Failure 403 {object} responses.ErrorResponse "Erişim reddedildi"
 // @Failure 404 {object} responses.ErrorResponse "Yorum bulunamadı"
+// @Failure 412 {object} responses.CommentResponse "Yorum başka biri tarafından değiştirildi; güncel hali döner"
+// @Failure 422 {object} responses.ErrorResponse "Düzenleme spam olarak reddedildi"
+// @Failure 428 {object} responses.ErrorResponse "If-Match veya version gerekli"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /comments/{comment_id} [put]
 func UpdateComment(c *gin.Context) {
@@ -179,7 +376,7 @@ func UpdateComment(c *gin.Context) {
 	}
 
 	var comment models.Comment
-	if err := database.DB.Where("id = ?", uint(commentID)).First(&comment).Error; err != nil {
+	if err := database.DB.Preload("Post").Where("id = ? AND removed_at IS NULL", uint(commentID)).First(&comment).Error; err != nil {
 		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
 		return
 	}
@@ -188,26 +385,126 @@ func UpdateComment(c *gin.Context) {
 		return
 	}
 
+	expected, ok := expectedVersion(c, input.Version)
+	if !ok {
+		return
+	}
+	if !versionMatches(expected, comment.Version) {
+		respondCommentConflict(c, comment.ID)
+		return
+	}
+
+	format := comment.ContentFormat
+	if input.ContentFormat != "" {
+		format = input.ContentFormat
+	}
+	if comment.Content == input.Content && comment.ContentFormat == format {
+		utils.SetVersionETag(c, comment.Version)
+		utils.CreateResponse(c, http.StatusOK, "Comment updated successfully", buildCommentResponse(comment))
+		return
+	}
+
+	holdReason, ok := checkCommentEdit(c, comment, user.(models.User), input.Content, "Could not update comment")
+	if !ok {
+		return
+	}
+
+	original := comment
 	comment.Content = input.Content
-	if err := database.DB.Save(&comment).Error; err != nil {
+	comment.ContentFormat = format
+	if err := renderCommentContent(&comment); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not render comment content", nil)
+		return
+	}
+	updates := map[string]interface{}{
+		"content":        comment.Content,
+		"content_format": comment.ContentFormat,
+		"content_html":   comment.ContentHTML,
+		"version":        bumpVersion,
+	}
+	if holdReason != "" {
+		updates["status"] = models.CommentStatusPending
+		comment.Status = models.CommentStatusPending
+	}
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := ensureCommentBaseRevision(tx, original); err != nil {
+			return err
+		}
+		result := tx.Model(&comment).Where("version = ?", original.Version).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errVersionConflict
+		}
+		comment.Version++
+		if err := search.IndexComment(tx, comment); err != nil {
+			return err
+		}
+		if holdReason != "" {
+			if err := search.IndexReplies(tx, comment); err != nil {
+				return err
+			}
+			if err := recordCommentModeration(tx, comment.ID, original.Status, comment.Status, models.SystemActorID, holdReason); err != nil {
+				return err
+			}
+		}
+		return recordCommentRevision(tx, comment, user.(models.User), input.ChangeNote)
+	})
+	if errors.Is(err, errVersionConflict) {
+		respondCommentConflict(c, comment.ID)
+		return
+	}
+	if err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not update comment", nil)
 		return
 	}
 
-	responseComment := responses.CommentResponse{
-		ID:        comment.ID,
-		Content:   comment.Content,
-		AuthorID:  comment.AuthorID,
-		PostID:    comment.PostID,
-		CreatedAt: comment.CreatedAt,
-		UpdatedAt: comment.UpdatedAt,
+	responseComment := buildCommentResponse(comment)
+	utils.SetVersionETag(c, comment.Version)
+	if holdReason != "" {
+		utils.CreateResponse(c, http.StatusAccepted, "Comment is awaiting moderation", responseComment)
+		return
 	}
 	utils.CreateResponse(c, http.StatusOK, "Comment updated successfully", responseComment)
 }
 
+// checkCommentEdit yorumun yeni içeriğini spam kontrolünden geçirir. Temiz bir
+// yorum sonradan spam'e çevrilemesin diye düzenlemeler ve sürüm geri yüklemeleri
+// de kontrol edilir; şüpheli içerik onaylanmış yorumu yeniden onaya gönderir ve
+// bunun gerekçesi döner. Moderatörlerin değişiklikleri kontrol edilmez. Yorum
+// reddedilirse veya kontrol başarısız olursa yanıtı yazar ve false döner.
+func checkCommentEdit(c *gin.Context, comment models.Comment, user models.User, content, failureMessage string) (string, bool) {
+	if canModerateComments(comment.Post, user) {
+		return "", true
+	}
+
+	result, err := spam.Evaluate(c.Request.Context(), spam.Submission{
+		Kind:   spam.KindCommentEdit,
+		UserID: &comment.AuthorID,
+		IP:     c.ClientIP(),
+		Text:   content,
+	})
+	if err != nil {
+		log.Printf("Spam check failed for edit of comment %d: %v", comment.ID, err)
+		utils.CreateResponse(c, http.StatusInternalServerError, failureMessage, nil)
+		return "", false
+	}
+	switch result.Action {
+	case spam.ActionReject:
+		utils.CreateResponse(c, http.StatusUnprocessableEntity, "Comment was rejected as spam", nil)
+		return "", false
+	case spam.ActionModerate:
+		if comment.Status == models.CommentStatusApproved {
+			return "Possible spam in edit: " + result.Reason(), true
+		}
+	}
+	return "", true
+}
+
 // RemoveComment godoc
 // @Summary Mevcut bir yorumu sil
-// @Description Belirli bir yorumu siler
+// @Description Belirli bir yorumu siler. Yanıtları olan bir yorum silindiğinde tartışma kopmasın diye yorum "[deleted]" yer tutucusu olarak kalır.
 // @Tags Comment
 // @Produce json
 // @Param comment_id path int true "Comment ID"
@@ -232,7 +529,7 @@ func RemoveComment(c *gin.Context) {
 	}
 
 	var comment models.Comment
-	if err := database.DB.Where("id = ?", uint(commentID)).First(&comment).Error; err != nil {
+	if err := database.DB.Where("id = ? AND removed_at IS NULL", uint(commentID)).First(&comment).Error; err != nil {
 		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
 		return
 	}
@@ -241,10 +538,42 @@ func RemoveComment(c *gin.Context) {
 		return
 	}
 
-	if err := database.DB.Delete(&comment).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		return removeComment(tx, comment)
+	})
+	if err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not delete comment", nil)
 		return
 	}
 
 	utils.CreateResponse(c, http.StatusOK, "Comment deleted successfully", nil)
 }
+
+func buildCommentResponse(comment models.Comment) responses.CommentResponse {
+	response := responses.CommentResponse{
+		ID:            comment.ID,
+		Content:       comment.Content,
+		ContentFormat: comment.ContentFormat,
+		ContentHTML:   comment.ContentHTML,
+		AuthorID:      comment.AuthorID,
+		PostID:        comment.PostID,
+		ParentID:      comment.ParentID,
+		Depth:         comment.Depth,
+		Status:        comment.Status,
+		Version:       comment.Version,
+		CreatedAt:     comment.CreatedAt,
+		UpdatedAt:     comment.UpdatedAt,
+	}
+	// Yer tutucunun yazarı gösterilmez
+	if comment.RemovedAt != nil {
+		response.Content = models.DeletedCommentPlaceholder
+		response.ContentHTML = "<p>" + models.DeletedCommentPlaceholder + "</p>"
+		response.AuthorID = uuid.Nil
+		response.Deleted = true
+	}
+	return response
+}
+
+func commentCursor(comment models.Comment) (interface{}, uint) {
+	return comment.CreatedAt, comment.ID
+}
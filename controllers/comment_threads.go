@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/responses"
+	"blog-platform/search"
+	"blog-platform/utils"
+	"errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultRepliesLimit her yorumun altında gömülü gelen yanıt sayısıdır.
+	defaultRepliesLimit = 3
+	maxRepliesLimit     = 20
+)
+
+// commentMaxDepth bir yanıtın üst düzey yorumun altında en fazla kaç seviye
+// iç içe olabileceğidir.
+func commentMaxDepth() int {
+	return utils.GetEnvInt("COMMENT_MAX_DEPTH", 5)
+}
+
+// parseRepliesLimit ?replies= parametresini okur; 0 yanıtları gömmeden yalnızca
+// sayılarını döndürür.
+func parseRepliesLimit(value string) (int, error) {
+	if value == "" {
+		return defaultRepliesLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		return 0, errors.New("replies must be a non-negative integer")
+	}
+	if limit > maxRepliesLimit {
+		limit = maxRepliesLimit
+	}
+	return limit, nil
+}
+
+// buildCommentThreads yorumların altına her seviyede en eski limit kadar yanıtı
+// yerleştirir. Yanıtlar seviye seviye yüklenir; sorgu sayısı en fazla izin
+// verilen derinlik kadardır. Gömülmeyen yanıtlar has_more_replies ile belirtilir
+// ve GET /comments/{comment_id}/replies ile alınır.
+func buildCommentThreads(comments []models.Comment, limit int) ([]responses.CommentResponse, error) {
+	children := make(map[uint][]models.Comment)
+	counts := make(map[uint]int64)
+
+	level := comments
+	for len(level) > 0 {
+		ids := make([]uint, 0, len(level))
+		for _, comment := range level {
+			ids = append(ids, comment.ID)
+		}
+		if err := countReplies(ids, counts); err != nil {
+			return nil, err
+		}
+		if limit == 0 {
+			break
+		}
+		replies, err := loadFirstReplies(ids, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, reply := range replies {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+		level = replies
+	}
+
+	var build func(models.Comment) responses.CommentResponse
+	build = func(comment models.Comment) responses.CommentResponse {
+		response := buildCommentResponse(comment)
+		response.ReplyCount = counts[comment.ID]
+		for _, reply := range children[comment.ID] {
+			response.Replies = append(response.Replies, build(reply))
+		}
+		response.HasMoreReplies = int64(len(response.Replies)) < response.ReplyCount
+		return response
+	}
+
+	threads := make([]responses.CommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		threads = append(threads, build(comment))
+	}
+	return threads, nil
+}
+
+// countReplies verilen yorumların doğrudan yanıt sayılarını counts'a yazar.
+func countReplies(parentIDs []uint, counts map[uint]int64) error {
+	var rows []struct {
+		ParentID uint
+		Count    int64
+	}
+	err := database.DB.Model(&models.Comment{}).Select("parent_id, COUNT(*) AS count").
+		Where("parent_id IN ? AND status = ?", parentIDs, models.CommentStatusApproved).Group("parent_id").Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	return nil
+}
+
+// loadFirstReplies her üst yorumun en eski limit kadar yanıtını tek sorguda yükler.
+func loadFirstReplies(parentIDs []uint, limit int) ([]models.Comment, error) {
+	ranked := database.DB.Model(&models.Comment{}).
+		Select("comments.*, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY created_at, id) AS reply_rank").
+		Where("parent_id IN ? AND status = ?", parentIDs, models.CommentStatusApproved)
+
+	var replies []models.Comment
+	err := database.DB.Table("(?) AS ranked", ranked).Where("reply_rank <= ?", limit).
+		Order("created_at").Order("id").Find(&replies).Error
+	return replies, err
+}
+
+// removeComment yorumu siler. Yanıtları olan yorum silinmez; içeriği ve
+// geçmişi temizlenerek "[deleted]" yer tutucusu olarak bırakılır, böylece
+// altındaki tartışma kopmaz. Yanıtsız bir yorum silindiğinde artık yanıtı
+// kalmayan yer tutucu ataları da silinir. Reddedilen yanıtlar hesaba katılmaz.
+func removeComment(tx *gorm.DB, comment models.Comment) error {
+	var replies int64
+	if err := countLiveReplies(tx, comment.ID, &replies); err != nil {
+		return err
+	}
+
+	if replies > 0 {
+		err := tx.Model(&comment).Updates(map[string]interface{}{
+			"content":      "",
+			"content_html": "",
+			"removed_at":   time.Now(),
+			"version":      bumpVersion,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("comment_id = ?", comment.ID).Delete(&models.CommentRevision{}).Error; err != nil {
+			return err
+		}
+		return search.RemoveComments(tx, comment.ID)
+	}
+
+	if err := tx.Delete(&comment).Error; err != nil {
+		return err
+	}
+	if err := search.RemoveComments(tx, comment.ID); err != nil {
+		return err
+	}
+
+	for parentID := comment.ParentID; parentID != nil; {
+		var parent models.Comment
+		err := tx.Where("id = ? AND removed_at IS NOT NULL", *parentID).First(&parent).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := countLiveReplies(tx, parent.ID, &replies); err != nil || replies > 0 {
+			return err
+		}
+		if err := tx.Delete(&parent).Error; err != nil {
+			return err
+		}
+		parentID = parent.ParentID
+	}
+	return nil
+}
+
+// countLiveReplies yorumun reddedilmemiş doğrudan yanıtlarını sayar.
+func countLiveReplies(tx *gorm.DB, commentID uint, count *int64) error {
+	return tx.Model(&models.Comment{}).Where("parent_id = ? AND status <> ?", commentID, models.CommentStatusRejected).Count(count).Error
+}
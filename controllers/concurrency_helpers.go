@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// errVersionConflict kayıt okunduktan sonra başka bir istek tarafından değiştirildiğinde döner.
+var errVersionConflict = errors.New("version conflict")
+
+// bumpVersion güncellemelere eklenerek kaydın sürümünü bir artırır.
+var bumpVersion = gorm.Expr("version + 1")
+
+// expectedVersion If-Match başlığını veya gövdedeki version alanını okur.
+// Ön koşul eksikse 428, geçersizse 400 yazar ve false döner.
+func expectedVersion(c *gin.Context, bodyVersion *int) (int, bool) {
+	version, err := utils.ExpectedVersion(c, bodyVersion)
+	switch {
+	case errors.Is(err, utils.ErrVersionRequired):
+		utils.CreateResponse(c, http.StatusPreconditionRequired, err.Error(), nil)
+		return 0, false
+	case err != nil:
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return 0, false
+	}
+	return version, true
+}
+
+// versionMatches istemcinin gördüğü sürümün güncel sürümle aynı olup olmadığını döner.
+func versionMatches(expected, current int) bool {
+	return expected == utils.AnyVersion || expected == current
+}
+
+// respondPostConflict postun güncel halini 412 ile döner; istemci değişiklikleri
+// bu hal üzerine yeniden uygulayabilir.
+func respondPostConflict(c *gin.Context, postID uint) {
+	var post models.Post
+	if err := database.DB.Preload("Categories").Preload("Tags").First(&post, postID).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Post not found", nil)
+		return
+	}
+	utils.SetVersionETag(c, post.Version)
+	utils.CreateResponse(c, http.StatusPreconditionFailed, "Post was modified by someone else", buildPostResponse(post))
+}
+
+// respondCommentConflict yorumun güncel halini 412 ile döner.
+func respondCommentConflict(c *gin.Context, commentID uint) {
+	var comment models.Comment
+	if err := database.DB.First(&comment, commentID).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
+		return
+	}
+	utils.SetVersionETag(c, comment.Version)
+	utils.CreateResponse(c, http.StatusPreconditionFailed, "Comment was modified by someone else", buildCommentResponse(comment))
+}
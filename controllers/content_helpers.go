@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"blog-platform/markup"
+	"blog-platform/models"
+)
+
+// contentFormatOrDefault istekte biçim verilmemişse markdown kullanır.
+func contentFormatOrDefault(format string) string {
+	if format == "" {
+		return markup.FormatMarkdown
+	}
+	return format
+}
+
+// renderPostContent postun içeriğini biçimine göre temizlenmiş HTML'e çevirir.
+// Yalnızca içerik veya biçim değiştiğinde çağrılır; HTML veritabanında saklanır.
+func renderPostContent(post *models.Post) error {
+	rendered, err := markup.Render(post.ContentFormat, post.Content, markup.AudiencePost)
+	if err != nil {
+		return err
+	}
+	post.ContentHTML = rendered
+	return nil
+}
+
+// renderCommentContent yorumun içeriğini HTML'e çevirir; yorumlardaki
+// bağlantılar rel="nofollow" alır.
+func renderCommentContent(comment *models.Comment) error {
+	rendered, err := markup.Render(comment.ContentFormat, comment.Content, markup.AudienceComment)
+	if err != nil {
+		return err
+	}
+	comment.ContentHTML = rendered
+	return nil
+}
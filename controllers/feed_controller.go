@@ -0,0 +1,263 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/feed"
+	"blog-platform/markup"
+	"blog-platform/models"
+	"blog-platform/utils"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// feedItemLimit bir beslemedeki en fazla post sayısıdır.
+const feedItemLimit = 20
+
+// GetRSSFeed godoc
+// @Summary RSS 2.0 beslemesi
+// @Description Son yayımlanmış postları RSS 2.0 olarak döner. Blogun tamamı, bir yazar, bir kategori (ID veya slug) veya bir etiket için besleme alınabilir. İçerik temizlenmiş HTML'dir. ETag ve Last-Modified ile koşullu istekleri destekler; yanıtlar kısa süre önbelleğe alınır.
+// @Tags Feed
+// @Produce xml
+// @Param username path string false "Yazarın kullanıcı adı"
+// @Param category_id path string false "Kategori ID veya slug"
+// @Param slug path string false "Etiket slug'ı"
+// @Param If-None-Match header string false "Önceki yanıttaki ETag"
+// @Param If-Modified-Since header string false "Önceki yanıttaki Last-Modified"
+// @Success 200 {string} string "RSS belgesi"
+// @Success 304 "Besleme değişmedi"
+// @Failure 404 {object} responses.ErrorResponse "Yazar, kategori veya etiket bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /feed.xml [get]
+// @Router /users/{username}/feed.xml [get]
+// @Router /category/{category_id}/feed.xml [get]
+// @Router /tags/{slug}/feed.xml [get]
+func GetRSSFeed(c *gin.Context) {
+	serveFeed(c, feed.FormatRSS)
+}
+
+// GetAtomFeed godoc
+// @Summary Atom 1.0 beslemesi
+// @Description Son yayımlanmış postları Atom 1.0 olarak döner; her girdi postun güncellenme zamanını taşır. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.
+// @Tags Feed
+// @Produce xml
+// @Param username path string false "Yazarın kullanıcı adı"
+// @Param category_id path string false "Kategori ID veya slug"
+// @Param slug path string false "Etiket slug'ı"
+// @Param If-None-Match header string false "Önceki yanıttaki ETag"
+// @Param If-Modified-Since header string false "Önceki yanıttaki Last-Modified"
+// @Success 200 {string} string "Atom belgesi"
+// @Success 304 "Besleme değişmedi"
+// @Failure 404 {object} responses.ErrorResponse "Yazar, kategori veya etiket bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /atom.xml [get]
+// @Router /users/{username}/atom.xml [get]
+// @Router /category/{category_id}/atom.xml [get]
+// @Router /tags/{slug}/atom.xml [get]
+func GetAtomFeed(c *gin.Context) {
+	serveFeed(c, feed.FormatAtom)
+}
+
+// GetJSONFeed godoc
+// @Summary JSON Feed 1.1 beslemesi
+// @Description Son yayımlanmış postları JSON Feed 1.1 olarak döner. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.
+// @Tags Feed
+// @Produce json
+// @Param username path string false "Yazarın kullanıcı adı"
+// @Param category_id path string false "Kategori ID veya slug"
+// @Param slug path string false "Etiket slug'ı"
+// @Param If-None-Match header string false "Önceki yanıttaki ETag"
+// @Param If-Modified-Since header string false "Önceki yanıttaki Last-Modified"
+// @Success 200 {string} string "JSON Feed belgesi"
+// @Success 304 "Besleme değişmedi"
+// @Failure 404 {object} responses.ErrorResponse "Yazar, kategori veya etiket bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /feed.json [get]
+// @Router /users/{username}/feed.json [get]
+// @Router /category/{category_id}/feed.json [get]
+// @Router /tags/{slug}/feed.json [get]
+func GetJSONFeed(c *gin.Context) {
+	serveFeed(c, feed.FormatJSON)
+}
+
+// serveFeed beslemeyi önbellekten veya veritabanından üretip koşullu istek
+// başlıklarına göre 200 ya da 304 döner.
+func serveFeed(c *gin.Context, format string) {
+	key := c.Request.URL.Path
+	entry, ok := feed.Feeds.Get(key)
+	if !ok {
+		scope, found, err := loadFeedScope(c)
+		if err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not build feed", nil)
+			return
+		}
+		if !found {
+			utils.CreateResponse(c, http.StatusNotFound, "Feed not found", nil)
+			return
+		}
+
+		f, err := buildFeed(c, scope)
+		if err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not build feed", nil)
+			return
+		}
+		body, err := feed.Encode(f, format)
+		if err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not build feed", nil)
+			return
+		}
+		// Gövdeden üretilen ETag, bir postun beslemeden çıkması gibi Last-Modified'ı
+		// değiştirmeyen durumları da yakalar
+		sum := sha256.Sum256(body)
+		entry = feed.Entry{Body: body, ETag: `"` + hex.EncodeToString(sum[:16]) + `"`, Modified: f.Updated.UTC().Truncate(time.Second)}
+		feed.Feeds.Set(key, entry)
+	}
+
+	c.Header("ETag", entry.ETag)
+	c.Header("Last-Modified", entry.Modified.Format(http.TimeFormat))
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(feed.Feeds.TTL().Seconds())))
+	if feedNotModified(c, entry) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, feed.ContentTypes[format], entry.Body)
+}
+
+// feedNotModified If-None-Match varsa yalnızca ona, yoksa If-Modified-Since'e bakar.
+func feedNotModified(c *gin.Context, entry feed.Entry) bool {
+	if header := c.GetHeader("If-None-Match"); header != "" {
+		for _, candidate := range strings.Split(header, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == entry.ETag {
+				return true
+			}
+		}
+		return false
+	}
+	if header := c.GetHeader("If-Modified-Since"); header != "" {
+		since, err := http.ParseTime(header)
+		return err == nil && !entry.Modified.After(since)
+	}
+	return false
+}
+
+// feedScope beslemenin kapsadığı postları ve başlığını tanımlar.
+type feedScope struct {
+	title  string
+	link   string
+	since  time.Time // Besleme boşken updated alanında kullanılır
+	filter func(*gorm.DB) *gorm.DB
+}
+
+// loadFeedScope yol parametresine göre blogun tamamı, yazar, kategori veya
+// etiket kapsamını döner; bilinmeyen bir kapsam için found false döner.
+func loadFeedScope(c *gin.Context) (feedScope, bool, error) {
+	blogTitle := os.Getenv("BLOG_TITLE")
+	if blogTitle == "" {
+		blogTitle = "Blog Platform"
+	}
+	scope := feedScope{
+		title:  blogTitle,
+		link:   appURL("/posts"),
+		filter: func(query *gorm.DB) *gorm.DB { return query },
+	}
+
+	switch {
+	case c.Param("username") != "":
+		var user models.User
+		err := database.DB.Where("username = ? AND deletion_scheduled_at IS NULL", c.Param("username")).First(&user).Error
+		if err != nil {
+			return scope, false, ignoreNotFound(err)
+		}
+		scope.title = fmt.Sprintf("%s: posts by %s", blogTitle, user.Username)
+		scope.link = appURL("/users/" + user.Username)
+		scope.filter = func(query *gorm.DB) *gorm.DB { return query.Where("posts.author_id = ?", user.ID) }
+
+	case c.Param("category_id") != "":
+		category, err := findCategoryByParam(c.Param("category_id"))
+		if err != nil {
+			return scope, false, ignoreNotFound(err)
+		}
+		scope.title = fmt.Sprintf("%s: %s", blogTitle, category.Name)
+		scope.link = appURL(fmt.Sprintf("/category/%s/posts", category.Slug))
+		scope.since = category.UpdatedAt
+		scope.filter = func(query *gorm.DB) *gorm.DB {
+			return query.Where("posts.id IN (SELECT post_id FROM post_categories WHERE category_id = ?)", category.ID)
+		}
+
+	case c.Param("slug") != "":
+		var tag models.Tag
+		if err := database.DB.Where("slug = ?", c.Param("slug")).First(&tag).Error; err != nil {
+			return scope, false, ignoreNotFound(err)
+		}
+		scope.title = fmt.Sprintf("%s: #%s", blogTitle, tag.Name)
+		scope.link = appURL("/tags/" + tag.Slug + "/posts")
+		scope.since = tag.UpdatedAt
+		scope.filter = func(query *gorm.DB) *gorm.DB {
+			return query.Where("posts.id IN (SELECT post_id FROM post_tags WHERE tag_id = ?)", tag.ID)
+		}
+	}
+	return scope, true, nil
+}
+
+// buildFeed kapsamdaki son yayımlanmış postlardan beslemeyi oluşturur.
+func buildFeed(c *gin.Context, scope feedScope) (feed.Feed, error) {
+	var posts []models.Post
+	// Silinmeyi bekleyen hesapların postları profilleri gibi gizlenir
+	query := database.DB.Model(&models.Post{}).Preload("Author").Preload("Tags").
+		Where("posts.status = ?", models.PostStatusPublished).
+		Where("posts.author_id NOT IN (SELECT id FROM users WHERE deletion_scheduled_at IS NOT NULL)")
+	err := scope.filter(query).
+		Order("COALESCE(posts.published_at, posts.created_at) DESC").Order("posts.id DESC").
+		Limit(feedItemLimit).Find(&posts).Error
+	if err != nil {
+		return feed.Feed{}, err
+	}
+
+	items := make([]feed.Item, 0, len(posts))
+	for _, post := range posts {
+		published := post.CreatedAt
+		if post.PublishedAt != nil {
+			published = *post.PublishedAt
+		}
+		item := feed.Item{
+			ID:          appURL(fmt.Sprintf("/posts/%d", post.ID)),
+			Title:       post.Title,
+			Link:        appURL("/posts/by-slug/" + post.Slug),
+			ContentHTML: post.ContentHTML,
+			Summary:     feed.Summarize(markup.PlainText(post.ContentHTML)),
+			Author:      feed.Author{Name: post.Author.Username, URL: appURL("/users/" + post.Author.Username)},
+			Published:   published,
+			Updated:     post.UpdatedAt,
+		}
+		for _, tag := range post.Tags {
+			item.Tags = append(item.Tags, tag.Name)
+		}
+		items = append(items, item)
+	}
+
+	return feed.Feed{
+		Title:       scope.title,
+		Description: "Latest posts from " + scope.title,
+		Link:        scope.link,
+		FeedURL:     appURL(c.Request.URL.Path),
+		Updated:     feed.LastUpdated(items, scope.since),
+		Items:       items,
+	}, nil
+}
+
+// ignoreNotFound kayıt bulunamadı hatasını nil'e çevirir.
+func ignoreNotFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,20 @@
+package controllers
+
+import (
+	"blog-platform/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetJWKS godoc
+// @Summary JSON Web Key Set
+// @Description Token doğrulamak için kullanılan açık anahtarları RFC 7517 formatında yayınlar
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} utils.JWKSet
+// @Router /.well-known/jwks.json [get]
+func GetJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, utils.Keys.JWKS())
+}
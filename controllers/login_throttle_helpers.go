@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/utils"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	// Bir hesap için gecikme uygulanmadan önce izin verilen hatalı deneme sayısı
+	loginFreeAttempts = 3
+	loginBaseDelay    = time.Second
+	loginMaxDelay     = time.Minute
+)
+
+// dummyPasswordHash kayıtlı olmayan emaillerde de bcrypt karşılaştırması
+// yapılarak yanıt süresinden hesabın varlığının anlaşılması engellenir.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+
+func accountThrottleKey(email string) string {
+	return "email:" + normalizeEmail(email)
+}
+
+func ipThrottleKey(ip string) string {
+	return "ip:" + ip
+}
+
+// loginLockoutDuration kilitlenmenin süresidir; aynı zamanda hatalı
+// denemelerin sayıldığı penceredir.
+func loginLockoutDuration() time.Duration {
+	return utils.GetEnvDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute)
+}
+
+// loginRetryAfter hesap veya IP için bir sonraki denemeye kadar beklenmesi
+// gereken süreyi döner. Hesap anahtarında hatalı denemeler arttıkça gecikme
+// katlanarak büyür; her iki anahtar da eşiği aşınca geçici olarak kilitlenir.
+func loginRetryAfter(accountKey, ipKey string) time.Duration {
+	var throttles []models.LoginThrottle
+	if err := database.DB.Where("key IN ?", []string{accountKey, ipKey}).Find(&throttles).Error; err != nil {
+		log.Printf("Could not read login throttle: %v", err)
+		return 0
+	}
+
+	now := time.Now()
+	var wait time.Duration
+	for _, throttle := range throttles {
+		until := now
+		if throttle.LockedUntil != nil && throttle.LockedUntil.After(now) {
+			until = *throttle.LockedUntil
+		} else if throttle.Key == accountKey && throttle.FailedCount >= loginFreeAttempts &&
+			now.Sub(throttle.LastFailedAt) < loginLockoutDuration() {
+			until = throttle.LastFailedAt.Add(loginDelay(throttle.FailedCount))
+		}
+
+		if remaining := until.Sub(now); remaining > wait {
+			wait = remaining
+		}
+	}
+	return wait
+}
+
+// loginDelay ücretsiz denemelerden sonraki her hatada ikiye katlanan gecikmedir.
+func loginDelay(failedCount int) time.Duration {
+	exponent := failedCount - loginFreeAttempts
+	if exponent < 0 {
+		return 0
+	}
+	delay := time.Duration(float64(loginBaseDelay) * math.Pow(2, float64(exponent)))
+	if delay > loginMaxDelay || delay <= 0 {
+		return loginMaxDelay
+	}
+	return delay
+}
+
+// recordLoginFailure hesap ve IP sayaçlarını artırır; eşiğe ulaşan anahtarı
+// kilitler ve kilitlenmeyi denetim kaydına yazar.
+func recordLoginFailure(c *gin.Context, userID *uuid.UUID, accountKey, ipKey string) {
+	recordThrottleFailure(c, userID, accountKey, utils.GetEnvInt("LOGIN_MAX_FAILED_ATTEMPTS", 10))
+	recordThrottleFailure(c, nil, ipKey, utils.GetEnvInt("LOGIN_IP_MAX_FAILED_ATTEMPTS", 50))
+}
+
+func recordThrottleFailure(c *gin.Context, userID *uuid.UUID, key string, limit int) {
+	now := time.Now()
+	window := loginLockoutDuration()
+
+	var throttle models.LoginThrottle
+	if err := database.DB.Where(models.LoginThrottle{Key: key}).FirstOrCreate(&throttle).Error; err != nil {
+		log.Printf("Could not record failed login for %s: %v", key, err)
+		return
+	}
+
+	// Pencere dışında kalan eski hatalar sayılmaz
+	if now.Sub(throttle.LastFailedAt) > window {
+		database.DB.Model(&models.LoginThrottle{}).
+			Where("id = ? AND last_failed_at = ?", throttle.ID, throttle.LastFailedAt).
+			Update("failed_count", 0)
+	}
+
+	// Eşzamanlı isteklerin sayacı ezmemesi için artış veritabanında yapılır
+	err := database.DB.Model(&models.LoginThrottle{}).Where("id = ?", throttle.ID).
+		UpdateColumns(map[string]interface{}{
+			"failed_count":   gorm.Expr("failed_count + 1"),
+			"last_failed_at": now,
+		}).Error
+	if err != nil {
+		log.Printf("Could not record failed login for %s: %v", key, err)
+		return
+	}
+
+	if err := database.DB.First(&throttle, throttle.ID).Error; err != nil || throttle.FailedCount < limit {
+		return
+	}
+
+	lockedUntil := now.Add(window)
+	result := database.DB.Model(&models.LoginThrottle{}).
+		Where("id = ? AND (locked_until IS NULL OR locked_until < ?)", throttle.ID, now).
+		Updates(map[string]interface{}{"locked_until": lockedUntil, "failed_count": 0})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return
+	}
+
+	label := throttleKeyLabel(key)
+	log.Printf("Login locked for %s until %s after %d failed attempts", label, lockedUntil.Format(time.RFC3339), throttle.FailedCount)
+	writeAuditLog(models.AuditLog{
+		Action:    models.AuditActionLoginLockout,
+		UserID:    userID,
+		IPAddress: c.ClientIP(),
+		Details:   fmt.Sprintf("%s locked until %s after %d failed attempts", label, lockedUntil.Format(time.RFC3339), throttle.FailedCount),
+	})
+}
+
+// throttleKeyLabel kayıtlara yazılacak anahtarı döner. Hesap anahtarları
+// e-posta adresini içerdiğinden yalnızca özetleri yazılır; kullanıcı
+// biliniyorsa denetim kaydının UserID alanından bulunabilir.
+func throttleKeyLabel(key string) string {
+	if !strings.HasPrefix(key, "email:") {
+		return key
+	}
+	sum := sha256.Sum256([]byte(key))
+	return "account " + hex.EncodeToString(sum[:8])
+}
+
+// resetLoginThrottle başarılı girişten sonra hesabın sayacını sıfırlar. IP
+// sayacı sıfırlanmaz; aksi halde geçerli bir hesabı olan saldırgan kendi
+// girişiyle IP sınırını aşabilirdi.
+func resetLoginThrottle(accountKey string) {
+	database.DB.Where("key = ?", accountKey).Delete(&models.LoginThrottle{})
+}
+
+// respondTooManyAttempts 429 ve Retry-After başlığı ile yanıt verir.
+func respondTooManyAttempts(c *gin.Context, wait time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	utils.CreateResponse(c, http.StatusTooManyRequests, "Too many failed login attempts, try again later", nil)
+}
+
+func writeAuditLog(entry models.AuditLog) {
+	if err := database.DB.Create(&entry).Error; err != nil {
+		log.Printf("Could not write audit log %s: %v", entry.Action, err)
+	}
+}
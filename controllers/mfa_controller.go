@@ -0,0 +1,381 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/responses"
+	"blog-platform/utils"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const recoveryCodeCount = 10
+
+// SetupTOTP godoc
+// @Summary TOTP kurulumunu başlat
+// @Description Yeni bir TOTP anahtarı üretir; authenticator uygulamasına eklemek için anahtarı, otpauth adresini ve QR kodunu döner. Kurulum /users/me/mfa/totp/confirm ile tamamlanana kadar giriş akışını etkilemez.
+// @Tags MFA
+// @Produce json
+// @Success 200 {object} responses.TOTPSetupResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 409 {object} responses.ErrorResponse "TOTP zaten etkin"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/mfa/totp/setup [post]
+func SetupTOTP(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	if currentUser.TOTPEnabled {
+		utils.CreateResponse(c, http.StatusConflict, "Two-factor authentication is already enabled", nil)
+		return
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not generate secret", nil)
+		return
+	}
+
+	if err := database.DB.Model(&currentUser).Update("totp_secret", secret).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not save secret", nil)
+		return
+	}
+
+	uri := utils.TOTPURI(currentUser.Email, secret)
+	png, err := qrcode.Encode(uri, qrcode.Medium, 256)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not generate QR code", nil)
+		return
+	}
+
+	response := responses.TOTPSetupResponse{
+		Secret:     secret,
+		OTPAuthURI: uri,
+		QRCode:     "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	}
+	utils.CreateResponse(c, http.StatusOK, "Scan the QR code and confirm with a code from your authenticator app", response)
+}
+
+// ConfirmTOTP godoc
+// @Summary TOTP kurulumunu onayla
+// @Description Authenticator uygulamasından alınan kod ile TOTP'yi etkinleştirir ve bir kez gösterilecek kurtarma kodlarını döner
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Param request body requests.MFACodeRequest true "TOTP kodu"
+// @Success 200 {object} responses.RecoveryCodesResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz kod"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 409 {object} responses.ErrorResponse "TOTP zaten etkin"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/mfa/totp/confirm [post]
+func ConfirmTOTP(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var input requests.MFACodeRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	if currentUser.TOTPEnabled {
+		utils.CreateResponse(c, http.StatusConflict, "Two-factor authentication is already enabled", nil)
+		return
+	}
+	if currentUser.TOTPSecret == "" {
+		utils.CreateResponse(c, http.StatusBadRequest, "Start the TOTP setup first", nil)
+		return
+	}
+
+	step, ok := utils.ValidateTOTP(currentUser.TOTPSecret, input.Code, time.Now(), currentUser.TOTPLastStep)
+	if !ok {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid code", nil)
+		return
+	}
+
+	var codes []string
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&currentUser).Updates(map[string]interface{}{
+			"totp_enabled":   true,
+			"totp_last_step": step,
+		}).Error; err != nil {
+			return err
+		}
+
+		var err error
+		codes, err = replaceRecoveryCodes(tx, currentUser)
+		return err
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not enable two-factor authentication", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Two-factor authentication enabled", responses.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Kurtarma kodlarını yenile
+// @Description Geçerli bir TOTP kodu ile eski kurtarma kodlarını geçersiz kılar ve yenilerini üretir
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Param request body requests.MFACodeRequest true "TOTP kodu"
+// @Success 200 {object} responses.RecoveryCodesResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz kod"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/mfa/recovery-codes [post]
+func RegenerateRecoveryCodes(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var input requests.MFACodeRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	if !currentUser.TOTPEnabled {
+		utils.CreateResponse(c, http.StatusBadRequest, "Two-factor authentication is not enabled", nil)
+		return
+	}
+
+	var codes []string
+	valid := false
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if valid, err = consumeTOTP(tx, currentUser, input.Code); err != nil || !valid {
+			return err
+		}
+
+		codes, err = replaceRecoveryCodes(tx, currentUser)
+		return err
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not regenerate recovery codes", nil)
+		return
+	}
+	if !valid {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid code", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Recovery codes regenerated", responses.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// DisableTOTP godoc
+// @Summary TOTP'yi devre dışı bırak
+// @Description Şifre ve TOTP/kurtarma kodu ile iki adımlı doğrulamayı kapatır; şifresi olmayan (yalnızca OIDC) hesaplarda kod yeterlidir. Rolü 2FA gerektiren kullanıcılar kapatamaz.
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Param request body requests.DisableMFARequest true "Şifre ve kod"
+// @Success 200 {object} responses.MessageResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz kod veya şifre"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 403 {object} responses.ErrorResponse "Rol 2FA gerektiriyor"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/mfa/totp [delete]
+func DisableTOTP(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var input requests.DisableMFARequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	if !currentUser.TOTPEnabled {
+		utils.CreateResponse(c, http.StatusBadRequest, "Two-factor authentication is not enabled", nil)
+		return
+	}
+
+	if utils.RequiresMFA(currentUser) {
+		utils.CreateResponse(c, http.StatusForbidden, "Two-factor authentication is required for your role", nil)
+		return
+	}
+
+	// Yalnızca OIDC ile giriş yapan hesapların şifresi yoktur; bu hesaplar
+	// için aşağıdaki TOTP veya kurtarma kodu yeterlidir
+	if currentUser.Password != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(currentUser.Password), []byte(input.Password)); err != nil {
+			utils.CreateResponse(c, http.StatusBadRequest, "Incorrect password", nil)
+			return
+		}
+	}
+
+	valid := false
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if valid, err = verifySecondFactor(tx, currentUser, input.Code); err != nil || !valid {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", currentUser.ID).Delete(&models.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&currentUser).Updates(map[string]interface{}{
+			"totp_enabled":   false,
+			"totp_secret":    "",
+			"totp_last_step": 0,
+		}).Error
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not disable two-factor authentication", nil)
+		return
+	}
+	if !valid {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid code", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Two-factor authentication disabled", nil)
+}
+
+// VerifyMFALogin godoc
+// @Summary İki adımlı girişi tamamla
+// @Description Giriş adımında dönen mfa_token ile TOTP veya kurtarma kodunu doğrular ve oturum token'larını döner
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body requests.MFALoginRequest true "MFA token ve kod"
+// @Success 200 {object} responses.LoginResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 401 {object} responses.ErrorResponse "Geçersiz token veya kod"
+// @Failure 429 {object} responses.ErrorResponse "Çok fazla hatalı deneme"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/login/mfa [post]
+func VerifyMFALogin(c *gin.Context) {
+	var input requests.MFALoginRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	userID, err := utils.ParseMFAToken(input.MFAToken)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Invalid or expired MFA token", nil)
+		return
+	}
+
+	var user models.User
+	if err := database.DB.First(&user, "id = ?", userID).Error; err != nil || !user.TOTPEnabled {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Invalid or expired MFA token", nil)
+		return
+	}
+
+	// İkinci adımdaki hatalı kodlar da hesabın giriş sayacına eklenir
+	accountKey, ipKey := accountThrottleKey(user.Email), ipThrottleKey(c.ClientIP())
+	if wait := loginRetryAfter(accountKey, ipKey); wait > 0 {
+		respondTooManyAttempts(c, wait)
+		return
+	}
+
+	valid := false
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		valid, err = verifySecondFactor(tx, user, input.Code)
+		return err
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not verify code", nil)
+		return
+	}
+	if !valid {
+		recordLoginFailure(c, &user.ID, accountKey, ipKey)
+		utils.CreateResponse(c, http.StatusUnauthorized, "Invalid code", nil)
+		return
+	}
+
+	resetLoginThrottle(accountKey)
+
+	loginResponse, err := startSession(c, user)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not generate token", nil)
+		return
+	}
+
+	loginResponse.Message = "Login successful"
+	utils.CreateResponse(c, http.StatusOK, "Login successful", loginResponse)
+}
+
+// verifySecondFactor kodu önce TOTP, olmazsa kurtarma kodu olarak doğrular.
+func verifySecondFactor(tx *gorm.DB, user models.User, code string) (bool, error) {
+	if valid, err := consumeTOTP(tx, user, code); err != nil || valid {
+		return valid, err
+	}
+	return consumeRecoveryCode(tx, user, code)
+}
+
+// consumeTOTP kodu doğrular ve kullanılan zaman adımını kaydederek aynı kodun
+// ikinci kez kabul edilmesini engeller.
+func consumeTOTP(tx *gorm.DB, user models.User, code string) (bool, error) {
+	step, ok := utils.ValidateTOTP(user.TOTPSecret, code, time.Now(), user.TOTPLastStep)
+	if !ok {
+		return false, nil
+	}
+
+	result := tx.Model(&models.User{}).
+		Where("id = ? AND totp_last_step < ?", user.ID, step).
+		Update("totp_last_step", step)
+	return result.RowsAffected == 1, result.Error
+}
+
+func consumeRecoveryCode(tx *gorm.DB, user models.User, code string) (bool, error) {
+	hash := utils.HashToken(utils.NormalizeRecoveryCode(code))
+	result := tx.Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", user.ID, hash).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// replaceRecoveryCodes kullanıcının tüm kurtarma kodlarını yenileriyle değiştirir
+// ve düz metin kodları (yalnızca bir kez gösterilmek üzere) döner.
+func replaceRecoveryCodes(tx *gorm.DB, user models.User) ([]string, error) {
+	if err := tx.Where("user_id = ?", user.ID).Delete(&models.RecoveryCode{}).Error; err != nil {
+		return nil, err
+	}
+
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]models.RecoveryCode, len(codes))
+	for i, code := range codes {
+		records[i] = models.RecoveryCode{
+			UserID:   user.ID,
+			CodeHash: utils.HashToken(utils.NormalizeRecoveryCode(code)),
+		}
+	}
+	if err := tx.Create(&records).Error; err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
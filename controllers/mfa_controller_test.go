@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// enableTOTPForTest kullanıcı için TOTP'yi açar ve bir kurtarma kodu döner.
+func enableTOTPForTest(t *testing.T, user models.User) string {
+	t.Helper()
+	err := database.DB.Model(&user).Updates(map[string]interface{}{
+		"totp_enabled": true,
+		"totp_secret":  "JBSWY3DPEHPK3PXP",
+	}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := replaceRecoveryCodes(database.DB, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return codes[0]
+}
+
+func disableTOTP(router *gin.Engine, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/mfa/totp", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+// mfaRouter DisableTOTP'yi verilen kullanıcı giriş yapmış gibi çalıştırır.
+func mfaRouter(t *testing.T, id uuid.UUID) *gin.Engine {
+	t.Helper()
+	router := gin.New()
+	router.DELETE("/users/me/mfa/totp", func(c *gin.Context) {
+		c.Set("user", reloadUser(t, id))
+	}, DisableTOTP)
+	return router
+}
+
+func TestDisableTOTPWithoutPasswordForOIDCAccount(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	if rec := login(t, idp, router, idpIdentity{Subject: "sub-1", Email: "oidc@example.com", EmailVerified: true}); rec.Code != http.StatusOK {
+		t.Fatalf("login: got %d: %s", rec.Code, rec.Body)
+	}
+	var user models.User
+	if err := database.DB.First(&user, "email = ?", "oidc@example.com").Error; err != nil {
+		t.Fatal(err)
+	}
+	recoveryCode := enableTOTPForTest(t, user)
+	mfa := mfaRouter(t, user.ID)
+
+	if rec := disableTOTP(mfa, `{"code":"not-a-code"}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("wrong code: got %d, want 400", rec.Code)
+	}
+	if !reloadUser(t, user.ID).TOTPEnabled {
+		t.Fatal("TOTP was disabled with a wrong code")
+	}
+
+	if rec := disableTOTP(mfa, `{"code":"`+recoveryCode+`"}`); rec.Code != http.StatusOK {
+		t.Fatalf("recovery code: got %d: %s", rec.Code, rec.Body)
+	}
+	if updated := reloadUser(t, user.ID); updated.TOTPEnabled || updated.TOTPSecret != "" {
+		t.Fatalf("TOTP still enabled: %+v", updated)
+	}
+}
+
+func TestDisableTOTPRequiresPasswordWhenSet(t *testing.T) {
+	setupOIDCTest(t)
+	user := createLocalUser(t, "local@example.com", true)
+	recoveryCode := enableTOTPForTest(t, user)
+	mfa := mfaRouter(t, user.ID)
+
+	if rec := disableTOTP(mfa, `{"code":"`+recoveryCode+`"}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("missing password: got %d, want 400", rec.Code)
+	}
+	if rec := disableTOTP(mfa, `{"password":"attacker-password","code":"`+recoveryCode+`"}`); rec.Code != http.StatusOK {
+		t.Fatalf("with password: got %d: %s", rec.Code, rec.Body)
+	}
+	if reloadUser(t, user.ID).TOTPEnabled {
+		t.Fatal("TOTP still enabled")
+	}
+}
@@ -0,0 +1,422 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/responses"
+	"blog-platform/search"
+	"blog-platform/utils"
+	"errors"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetModerationQueue godoc
+// @Summary Moderasyon kuyruğunu getir
+// @Description Onay bekleyen yorumları eskiden yeniye sayfalı olarak listeler. Editor ve Admin tüm yorumları, diğer kullanıcılar yalnızca kendi postlarındaki yorumları görür. status ile onaylanmış veya reddedilmiş yorumlar, post_id ile tek bir postun yorumları listelenebilir.
+// @Tags Moderation
+// @Produce json
+// @Param status query string false "Yorum durumu (varsayılan pending)" Enums(pending, approved, rejected)
+// @Param post_id query int false "Post ID"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param sort query string false "Sıralama (varsayılan oldest)" Enums(newest, oldest)
+// @Success 200 {object} responses.CommentsResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /moderation/comments [get]
+func GetModerationQueue(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	status := c.DefaultQuery("status", models.CommentStatusPending)
+	if status != models.CommentStatusPending && status != models.CommentStatusApproved && status != models.CommentStatusRejected {
+		utils.CreateResponse(c, http.StatusBadRequest, "Status must be pending, approved or rejected", nil)
+		return
+	}
+
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	sortKey, err := timeSortKey(c, "comments", "oldest")
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	query := database.DB.Model(&models.Comment{}).Where("status = ? AND removed_at IS NULL", status)
+	if postID := c.Query("post_id"); postID != "" {
+		query = query.Where("post_id = ?", postID)
+	}
+	if !isEditor(currentUser) {
+		ownPosts := database.DB.Model(&models.Post{}).Select("id").Where("author_id = ?", currentUser.ID)
+		query = query.Where("post_id IN (?)", ownPosts)
+	}
+
+	comments, meta, err := utils.Paginate(c, pagination, query, sortKey, "comments.id", commentCursor)
+	if err != nil {
+		respondListError(c, err, "Could not retrieve moderation queue")
+		return
+	}
+
+	responseComments := make([]responses.CommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		responseComments = append(responseComments, buildCommentResponse(comment))
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Moderation queue retrieved successfully", responses.CommentsResponse{Comments: responseComments}, meta)
+}
+
+// ApproveComment godoc
+// @Summary Yorumu onayla
+// @Description Bekleyen veya reddedilmiş yorumu yayımlar. Editor, Admin ve postun yazarı onaylayabilir. Yanıtlar, üst yorumları onaylanmadan onaylanamaz.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param comment_id path int true "Comment ID"
+// @Param request body requests.ModerateCommentRequest false "Karar nedeni"
+// @Success 200 {object} responses.CommentResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 403 {object} responses.ErrorResponse "Moderasyon yetkisi yok"
+// @Failure 404 {object} responses.ErrorResponse "Yorum bulunamadı"
+// @Failure 409 {object} responses.ErrorResponse "Yorum zaten onaylanmış veya üst yorum onaylanmamış"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /moderation/comments/{comment_id}/approve [post]
+func ApproveComment(c *gin.Context) {
+	moderateCommentFromRequest(c, models.CommentStatusApproved)
+}
+
+// RejectComment godoc
+// @Summary Yorumu reddet
+// @Description Yorumu gizler ve arama indeksinden çıkarır; neden zorunludur. Editor, Admin ve postun yazarı reddedebilir. Onaylanmış bir yorum reddedilirse yanıtları da gizlenir.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param comment_id path int true "Comment ID"
+// @Param request body requests.ModerateCommentRequest true "Ret nedeni"
+// @Success 200 {object} responses.CommentResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri veya eksik neden"
+// @Failure 403 {object} responses.ErrorResponse "Moderasyon yetkisi yok"
+// @Failure 404 {object} responses.ErrorResponse "Yorum bulunamadı"
+// @Failure 409 {object} responses.ErrorResponse "Yorum zaten reddedilmiş"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /moderation/comments/{comment_id}/reject [post]
+func RejectComment(c *gin.Context) {
+	moderateCommentFromRequest(c, models.CommentStatusRejected)
+}
+
+// BulkModerateComments godoc
+// @Summary Yorumları toplu olarak onayla veya reddet
+// @Description Aynı kararı en fazla 100 yoruma uygular. Yorumlar ID sırasıyla işlenir, böylece bir yanıt üst yorumuyla birlikte onaylanabilir. Karar verilemeyen yorumlar nedenleriyle skipped içinde döner; diğerleri etkilenmez.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param request body requests.BulkModerateCommentsRequest true "Yorumlar, karar ve neden"
+// @Success 200 {object} responses.BulkModerationResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri veya eksik neden"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /moderation/comments/bulk [post]
+func BulkModerateComments(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var input requests.BulkModerateCommentsRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	status := models.CommentStatusApproved
+	if input.Action == "reject" {
+		status = models.CommentStatusRejected
+	}
+	reason := strings.TrimSpace(input.Reason)
+	if status == models.CommentStatusRejected && reason == "" {
+		utils.CreateResponse(c, http.StatusBadRequest, "A reason is required when rejecting comments", nil)
+		return
+	}
+
+	ids := append([]uint(nil), input.CommentIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	result := responses.BulkModerationResponse{
+		Moderated: []responses.CommentResponse{},
+		Skipped:   []responses.BulkModerationSkip{},
+	}
+	for i, id := range ids {
+		if i > 0 && ids[i-1] == id {
+			continue
+		}
+		comment, err := moderateComment(id, currentUser, status, reason)
+		var workflowErr workflowError
+		switch {
+		case errors.As(err, &workflowErr):
+			result.Skipped = append(result.Skipped, responses.BulkModerationSkip{CommentID: id, Error: workflowErr.message})
+		case err != nil:
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not moderate comments", nil)
+			return
+		default:
+			result.Moderated = append(result.Moderated, buildCommentResponse(comment))
+		}
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Comments moderated successfully", result)
+}
+
+// GetCommentModerationHistory godoc
+// @Summary Yorumun moderasyon geçmişini getir
+// @Description Yorum hakkında verilen otomatik ve elle alınmış tüm kararları, karar verenle ve nedeniyle eskiden yeniye listeler. Otomatik kararların actor_id değeri sıfır UUID'dir. Yorumun yazarı, postun yazarı, Editor ve Admin görebilir.
+// @Tags Moderation
+// @Produce json
+// @Param comment_id path int true "Comment ID"
+// @Success 200 {object} []responses.CommentModerationResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 403 {object} responses.ErrorResponse "Erişim reddedildi"
+// @Failure 404 {object} responses.ErrorResponse "Yorum bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /moderation/comments/{comment_id}/history [get]
+func GetCommentModerationHistory(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var comment models.Comment
+	if err := database.DB.Preload("Post").Where("id = ? AND removed_at IS NULL", c.Param("comment_id")).First(&comment).Error; err != nil || comment.Post.ID == 0 {
+		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
+		return
+	}
+	if comment.AuthorID != currentUser.ID && !canModerateComments(comment.Post, currentUser) {
+		utils.CreateResponse(c, http.StatusForbidden, "You are not allowed to view this comment's moderation history", nil)
+		return
+	}
+
+	var decisions []models.CommentModeration
+	if err := database.DB.Where("comment_id = ?", comment.ID).Order("created_at, id").Find(&decisions).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve moderation history", nil)
+		return
+	}
+
+	history := make([]responses.CommentModerationResponse, 0, len(decisions))
+	for _, decision := range decisions {
+		history = append(history, responses.CommentModerationResponse{
+			FromStatus: decision.FromStatus,
+			ToStatus:   decision.ToStatus,
+			ActorID:    decision.ActorID,
+			Reason:     decision.Reason,
+			CreatedAt:  decision.CreatedAt,
+		})
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Moderation history retrieved successfully", history)
+}
+
+// moderateCommentFromRequest onay ve ret uçlarının ortak gövdesidir.
+func moderateCommentFromRequest(c *gin.Context, status string) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	commentID, err := strconv.ParseUint(c.Param("comment_id"), 10, 32)
+	if err != nil || commentID == 0 {
+		utils.CreateResponse(c, http.StatusBadRequest, "Valid Comment ID is required", nil)
+		return
+	}
+
+	// Onayda gövde isteğe bağlıdır
+	var input requests.ModerateCommentRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+	}
+	reason := strings.TrimSpace(input.Reason)
+	if status == models.CommentStatusRejected && reason == "" {
+		utils.CreateResponse(c, http.StatusBadRequest, "A reason is required when rejecting a comment", nil)
+		return
+	}
+
+	comment, err := moderateComment(uint(commentID), user.(models.User), status, reason)
+	if err != nil {
+		respondModerationError(c, err)
+		return
+	}
+
+	utils.SetVersionETag(c, comment.Version)
+	utils.CreateResponse(c, http.StatusOK, "Comment "+status+" successfully", buildCommentResponse(comment))
+}
+
+// moderateComment yorumun durumunu kullanıcının kararıyla değiştirir. Yetki ve
+// durum hataları workflowError olarak döner.
+func moderateComment(commentID uint, user models.User, status, reason string) (models.Comment, error) {
+	var comment models.Comment
+	err := database.DB.Preload("Post").Where("id = ? AND removed_at IS NULL", commentID).First(&comment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && comment.Post.ID == 0) {
+		return comment, workflowError{http.StatusNotFound, "Comment not found"}
+	}
+	if err != nil {
+		return comment, err
+	}
+	if !canModerateComments(comment.Post, user) {
+		return comment, workflowError{http.StatusForbidden, "You are not allowed to moderate this comment"}
+	}
+	if comment.Status == status {
+		return comment, workflowError{http.StatusConflict, "Comment is already " + status}
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		// Onaylanmamış bir yorumun altındaki yanıt görünmez kalacağından önce üst yorum onaylanmalıdır
+		if status == models.CommentStatusApproved && comment.ParentID != nil {
+			var parents int64
+			if err := tx.Model(&models.Comment{}).Where("id = ? AND status = ?", *comment.ParentID, models.CommentStatusApproved).Count(&parents).Error; err != nil {
+				return err
+			}
+			if parents == 0 {
+				return workflowError{http.StatusConflict, "Parent comment is not approved"}
+			}
+		}
+		return setCommentStatus(tx, &comment, status, user.ID, reason)
+	})
+	return comment, err
+}
+
+// setCommentStatus yorumun durumunu değiştirir, arama indeksini günceller ve
+// kararı kaydeder.
+func setCommentStatus(tx *gorm.DB, comment *models.Comment, status string, actorID uuid.UUID, reason string) error {
+	from, version := comment.Status, comment.Version
+	err := tx.Model(comment).Updates(map[string]interface{}{
+		"status":  status,
+		"version": bumpVersion,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	comment.Status = status
+	comment.Version = version + 1
+	// Bir moderatörün onayı, kayıtta spam olarak işaretlenen yazarın işaretini kaldırır
+	if status == models.CommentStatusApproved {
+		err := tx.Model(&models.User{}).Where("id = ? AND spam_flagged_at IS NOT NULL", comment.AuthorID).
+			Update("spam_flagged_at", nil).Error
+		if err != nil {
+			return err
+		}
+	}
+	if err := search.IndexComment(tx, *comment); err != nil {
+		return err
+	}
+	// Ağaçta yorumla birlikte gizlenen veya yeniden görünen yanıtlar
+	if err := search.IndexReplies(tx, *comment); err != nil {
+		return err
+	}
+	return recordCommentModeration(tx, comment.ID, from, status, actorID, reason)
+}
+
+// recordCommentModeration bir moderasyon kararını kaydeder.
+func recordCommentModeration(tx *gorm.DB, commentID uint, from, to string, actorID uuid.UUID, reason string) error {
+	return tx.Create(&models.CommentModeration{
+		CommentID:  commentID,
+		FromStatus: from,
+		ToStatus:   to,
+		ActorID:    actorID,
+		Reason:     reason,
+	}).Error
+}
+
+// respondModerationError moderasyon hatasını uygun durum koduyla yazar.
+func respondModerationError(c *gin.Context, err error) {
+	var workflowErr workflowError
+	if errors.As(err, &workflowErr) {
+		utils.CreateResponse(c, workflowErr.status, workflowErr.message, nil)
+		return
+	}
+	utils.CreateResponse(c, http.StatusInternalServerError, "Could not moderate comment", nil)
+}
+
+// commentModeFor postun yorum ayarını, ayar yoksa sitenin COMMENT_MODERATION
+// ayarını döner.
+func commentModeFor(post models.Post) string {
+	if post.CommentMode != "" {
+		return post.CommentMode
+	}
+	if mode := os.Getenv("COMMENT_MODERATION"); models.IsValidCommentMode(mode) {
+		return mode
+	}
+	return models.CommentModeOpen
+}
+
+// canModerateComments postun yazarının, Editor ve Admin'in posttaki yorumları
+// yönetebileceğini belirtir.
+func canModerateComments(post models.Post, user models.User) bool {
+	return post.AuthorID == user.ID || isEditor(user)
+}
+
+// initialCommentStatus yeni bir yorumun durumunu ve beklemeye alındıysa
+// nedenini döner. Yorumları yönetebilenlerin yorumları her zaman yayımlanır.
+func initialCommentStatus(post models.Post, author models.User) (string, string, error) {
+	if canModerateComments(post, author) {
+		return models.CommentStatusApproved, "", nil
+	}
+	if author.SpamFlaggedAt != nil {
+		return models.CommentStatusPending, "Account was flagged as possible spam at registration", nil
+	}
+	if commentModeFor(post) == models.CommentModeModerated {
+		return models.CommentStatusPending, "Comments on this post require approval", nil
+	}
+
+	trusted, err := isTrustedCommenter(author)
+	if err != nil {
+		return "", "", err
+	}
+	if !trusted {
+		return models.CommentStatusPending, "Comments from new or untrusted users require approval", nil
+	}
+	return models.CommentStatusApproved, "", nil
+}
+
+// isTrustedCommenter e-postası doğrulanmış, en az COMMENT_TRUST_THRESHOLD
+// onaylanmış yorumu olan ve reddedilen yorumları onaylananları geçmeyen
+// kullanıcıları güvenilir sayar.
+func isTrustedCommenter(user models.User) (bool, error) {
+	if !user.EmailVerified {
+		return false, nil
+	}
+
+	var counts struct {
+		Approved int64
+		Rejected int64
+	}
+	err := database.DB.Unscoped().Model(&models.Comment{}).
+		Select("COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS approved, COALESCE(SUM(CASE WHEN status = ? THEN 1 ELSE 0 END), 0) AS rejected",
+			models.CommentStatusApproved, models.CommentStatusRejected).
+		Where("author_id = ?", user.ID).Scan(&counts).Error
+	if err != nil {
+		return false, err
+	}
+	return counts.Approved >= int64(utils.GetEnvInt("COMMENT_TRUST_THRESHOLD", 1)) && counts.Rejected <= counts.Approved, nil
+}
@@ -0,0 +1,339 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/oidc"
+	"blog-platform/utils"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const oauthStateTTL = 10 * time.Minute
+
+// oidcStateCookie girişi başlatan tarayıcıya verilen state'i taşır. Dönüşte
+// sorgudaki state bu çerezle eşleşmelidir; aksi halde saldırgan kendi
+// akışının dönüş adresini kurbana açtırıp onu kendi hesabına sokabilir.
+const oidcStateCookie = "oidc_state"
+
+var errUnverifiedOIDCEmail = errors.New("identity provider did not verify the email address")
+
+// OIDCLogin godoc
+// @Summary OIDC ile giriş başlat
+// @Description Kullanıcıyı state, nonce ve PKCE parametreleriyle kimlik sağlayıcının giriş sayfasına yönlendirir ve state'i oidc_state çerezine yazar
+// @Tags Auth
+// @Success 302 {string} string "Kimlik sağlayıcıya yönlendirme"
+// @Failure 404 {object} responses.ErrorResponse "OIDC yapılandırılmamış"
+// @Failure 502 {object} responses.ErrorResponse "Kimlik sağlayıcıya ulaşılamadı"
+// @Router /auth/oidc/login [get]
+func OIDCLogin(c *gin.Context) {
+	provider := oidc.Default
+	if provider == nil {
+		utils.CreateResponse(c, http.StatusNotFound, oidc.ErrNotConfigured.Error(), nil)
+		return
+	}
+
+	state, errState := utils.GenerateRandomToken(24)
+	nonce, errNonce := utils.GenerateRandomToken(24)
+	verifier, errVerifier := utils.GenerateRandomToken(32)
+	if errState != nil || errNonce != nil || errVerifier != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not start login", nil)
+		return
+	}
+
+	record := models.OAuthState{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().Add(oauthStateTTL),
+	}
+	if err := database.DB.Create(&record).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not start login", nil)
+		return
+	}
+
+	// Süresi dolmuş ve hiç tamamlanmamış akışları temizle
+	database.DB.Where("expires_at < ?", time.Now()).Delete(&models.OAuthState{})
+
+	authURL, err := provider.AuthCodeURL(c.Request.Context(), state, nonce, verifier)
+	if err != nil {
+		log.Printf("OIDC discovery failed: %v", err)
+		utils.CreateResponse(c, http.StatusBadGateway, "Identity provider is unavailable", nil)
+		return
+	}
+
+	setOIDCStateCookie(c, state, int(oauthStateTTL.Seconds()))
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback godoc
+// @Summary OIDC giriş dönüşü
+// @Description Authorization code'u doğrular, kullanıcıyı doğrulanmış email ile oluşturur veya mevcut hesaba bağlar, IdP gruplarını rollere eşler ve oturum token'larını döner. state, girişi başlatan tarayıcıya verilen oidc_state çereziyle eşleşmelidir. Email'i doğrulanmamış bir hesaba bağlanırken o hesabın şifresi, iki adımlı doğrulaması, oturumları ve token'ları kaldırılır.
+// @Tags Auth
+// @Produce json
+// @Param code query string true "Authorization code"
+// @Param state query string true "State"
+// @Success 200 {object} responses.LoginResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veya süresi dolmuş state"
+// @Failure 401 {object} responses.ErrorResponse "Kimlik doğrulanamadı"
+// @Failure 403 {object} responses.ErrorResponse "Email doğrulanmamış"
+// @Failure 404 {object} responses.ErrorResponse "OIDC yapılandırılmamış"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /auth/oidc/callback [get]
+func OIDCCallback(c *gin.Context) {
+	provider := oidc.Default
+	if provider == nil {
+		utils.CreateResponse(c, http.StatusNotFound, oidc.ErrNotConfigured.Error(), nil)
+		return
+	}
+
+	if idpError := c.Query("error"); idpError != "" {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Identity provider returned an error: "+idpError, nil)
+		return
+	}
+
+	state, code := c.Query("state"), c.Query("code")
+	if state == "" || code == "" {
+		utils.CreateResponse(c, http.StatusBadRequest, "Missing code or state", nil)
+		return
+	}
+
+	// Akışı bu tarayıcı başlatmadıysa state tüketilmeden reddedilir
+	cookieState, _ := c.Cookie(oidcStateCookie)
+	setOIDCStateCookie(c, "", -1)
+	if subtle.ConstantTimeCompare([]byte(cookieState), []byte(state)) != 1 {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid or expired state", nil)
+		return
+	}
+
+	// State tek kullanımlıktır; silme işlemi başarılı olan istek devam eder
+	var record models.OAuthState
+	if err := database.DB.Where("state = ?", state).First(&record).Error; err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid or expired state", nil)
+		return
+	}
+	result := database.DB.Delete(&record)
+	if result.Error != nil || result.RowsAffected == 0 || time.Now().After(record.ExpiresAt) {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid or expired state", nil)
+		return
+	}
+
+	claims, err := provider.Exchange(c.Request.Context(), code, record.CodeVerifier, record.Nonce)
+	if err != nil {
+		log.Printf("OIDC code exchange failed: %v", err)
+		utils.CreateResponse(c, http.StatusUnauthorized, "Could not authenticate with identity provider", nil)
+		return
+	}
+
+	var user models.User
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if user, err = findOrCreateOIDCUser(tx, claims); err != nil {
+			return err
+		}
+		return syncOIDCRoles(tx, provider, user, claims.Groups)
+	})
+	if errors.Is(err, errUnverifiedOIDCEmail) {
+		utils.CreateResponse(c, http.StatusForbidden, err.Error(), nil)
+		return
+	}
+	if err != nil {
+		log.Printf("OIDC user provisioning failed: %v", err)
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not sign in", nil)
+		return
+	}
+
+	if err := database.DB.Preload("Roles").First(&user, "id = ?", user.ID).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not sign in", nil)
+		return
+	}
+
+	completeLogin(c, user)
+}
+
+// findOrCreateOIDCUser kullanıcıyı önce bağlı kimlikten, sonra doğrulanmış
+// email adresinden bulur; ikisi de yoksa yeni bir kullanıcı oluşturur.
+func findOrCreateOIDCUser(tx *gorm.DB, claims *oidc.Claims) (models.User, error) {
+	var user models.User
+	claims.Email = normalizeEmail(claims.Email)
+
+	var identity models.UserIdentity
+	err := tx.Where("issuer = ? AND subject = ?", claims.Issuer, claims.Subject).First(&identity).Error
+	if err == nil {
+		if err := tx.First(&user, "id = ?", identity.UserID).Error; err != nil {
+			return user, err
+		}
+		if claims.Email != "" && identity.Email != claims.Email {
+			tx.Model(&identity).Update("email", claims.Email)
+		}
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, err
+	}
+
+	// Doğrulanmamış bir email ile mevcut bir hesabı ele geçirmek mümkün olmamalı
+	if claims.Email == "" || !claims.EmailVerified {
+		return user, errUnverifiedOIDCEmail
+	}
+
+	err = tx.Where("email = ?", claims.Email).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		username, err := uniqueUsername(tx, firstNonEmpty(claims.PreferredUsername, strings.Split(claims.Email, "@")[0]))
+		if err != nil {
+			return user, err
+		}
+
+		now := time.Now()
+		user = models.User{
+			ID:            uuid.New(),
+			FirstName:     claims.GivenName,
+			LastName:      claims.FamilyName,
+			Username:      username,
+			Email:         claims.Email,
+			EmailVerified: true,
+			VerifiedAt:    &now,
+		}
+		if err := tx.Create(&user).Error; err != nil {
+			return user, err
+		}
+	} else if err != nil {
+		return user, err
+	} else if !user.EmailVerified {
+		if err := claimUnverifiedAccount(tx, &user); err != nil {
+			return user, err
+		}
+	}
+
+	identity = models.UserIdentity{
+		UserID:  user.ID,
+		Issuer:  claims.Issuer,
+		Subject: claims.Subject,
+		Email:   claims.Email,
+	}
+	return user, tx.Create(&identity).Error
+}
+
+// claimUnverifiedAccount email'i doğrulanmamış yerel hesabı, email'in sahibi
+// olduğunu IdP ile kanıtlayan kişiye devreder. Hesabı email'in sahibinden önce
+// başka biri açmış olabileceğinden o kişinin elinde kalabilecek tüm giriş
+// yolları kapatılır: şifre, iki adımlı doğrulama, oturumlar, erişim token'ları
+// ve bekleyen e-posta token'ları. Kullanıcı isterse şifre sıfırlama ile yeni
+// bir şifre belirleyebilir.
+func claimUnverifiedAccount(tx *gorm.DB, user *models.User) error {
+	now := time.Now()
+	err := tx.Model(user).Updates(map[string]interface{}{
+		"email_verified": true,
+		"verified_at":    now,
+		"password":       "",
+		"totp_enabled":   false,
+		"totp_secret":    "",
+		"totp_last_step": 0,
+	}).Error
+	if err != nil {
+		return err
+	}
+	if err := tx.Where("user_id = ?", user.ID).Delete(&models.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&models.UserToken{}).Where("user_id = ? AND used_at IS NULL", user.ID).Update("used_at", now).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&models.PersonalAccessToken{}).Where("user_id = ? AND revoked_at IS NULL", user.ID).Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	return revokeUserSessions(tx, user.ID)
+}
+
+// setOIDCStateCookie state çerezini yazar; maxAge negatifse çerezi siler.
+// Çerez IdP'den dönen çapraz site yönlendirmesinde de gönderilmesi için Lax'tır.
+func setOIDCStateCookie(c *gin.Context, state string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	secure := c.Request.TLS != nil || strings.HasPrefix(appURL(""), "https://")
+	c.SetCookie(oidcStateCookie, state, maxAge, "/auth/oidc", "", secure, true)
+}
+
+// syncOIDCRoles eşlemede geçen rolleri kullanıcının IdP gruplarına göre
+// ekler veya kaldırır; eşlemede olmayan rollere dokunmaz.
+func syncOIDCRoles(tx *gorm.DB, provider *oidc.Provider, user models.User, groups []string) error {
+	managed := provider.ManagedRoles()
+	if len(managed) == 0 {
+		return nil
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range provider.MappedRoles(groups) {
+		wanted[name] = true
+	}
+
+	var roles []models.Role
+	if err := tx.Where("name IN ?", managed).Find(&roles).Error; err != nil {
+		return err
+	}
+
+	var grant, revoke []models.Role
+	for _, role := range roles {
+		if wanted[role.Name] {
+			grant = append(grant, role)
+		} else {
+			revoke = append(revoke, role)
+		}
+	}
+
+	if len(revoke) > 0 {
+		if err := tx.Model(&user).Association("Roles").Delete(revoke); err != nil {
+			return err
+		}
+	}
+	if len(grant) > 0 {
+		if err := tx.Model(&user).Association("Roles").Append(grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var usernameCleaner = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// uniqueUsername verilen adı temizler ve başka bir kullanıcı tarafından
+// kullanılıyorsa sonuna sayı ekler.
+func uniqueUsername(tx *gorm.DB, base string) (string, error) {
+	base = strings.Trim(usernameCleaner.ReplaceAllString(base, ""), ".-")
+	if len(base) > 26 {
+		base = base[:26]
+	}
+	if validateUsername(base) != nil {
+		base = "user"
+	}
+
+	candidate := base
+	for i := 2; ; i++ {
+		var count int64
+		if err := tx.Model(&models.User{}).Where("username = ?", candidate).Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s%d", base, i)
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
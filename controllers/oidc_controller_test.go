@@ -0,0 +1,440 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/oidc"
+	"blog-platform/utils"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const testClientID = "blog-test"
+
+// idpIdentity kimlik sağlayıcının bir sonraki girişte döneceği kullanıcıdır.
+type idpIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+type authorization struct {
+	identity    idpIdentity
+	challenge   string
+	nonce       string
+	redirectURI string
+}
+
+// fakeIdP PKCE ve nonce'u gerçek bir sağlayıcı gibi denetleyen, httptest
+// üzerinde çalışan bir OpenID Connect sağlayıcısıdır.
+type fakeIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]authorization
+	// Boş değilse ID token'a istekteki nonce yerine bu yazılır
+	nonceOverride string
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &fakeIdP{key: key, codes: map[string]authorization{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(oidc.Discovery{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", idp.token)
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+// authorize kullanıcının sağlayıcıda giriş yapmasını taklit eder ve
+// uygulamaya dönecek code'u üretir.
+func (idp *fakeIdP) authorize(t *testing.T, authURL string, identity idpIdentity) (code, state string) {
+	t.Helper()
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := parsed.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		t.Fatalf("authorization request has no S256 code challenge: %s", authURL)
+	}
+	if query.Get("nonce") == "" || query.Get("state") == "" {
+		t.Fatalf("authorization request has no nonce or state: %s", authURL)
+	}
+
+	code = uuid.NewString()
+	idp.mu.Lock()
+	idp.codes[code] = authorization{
+		identity:    identity,
+		challenge:   query.Get("code_challenge"),
+		nonce:       query.Get("nonce"),
+		redirectURI: query.Get("redirect_uri"),
+	}
+	idp.mu.Unlock()
+	return code, query.Get("state")
+}
+
+func (idp *fakeIdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	idp.mu.Lock()
+	auth, ok := idp.codes[r.PostForm.Get("code")]
+	delete(idp.codes, r.PostForm.Get("code"))
+	nonce := auth.nonce
+	if idp.nonceOverride != "" {
+		nonce = idp.nonceOverride
+	}
+	idp.mu.Unlock()
+
+	switch {
+	case !ok:
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	case oidc.CodeChallenge(r.PostForm.Get("code_verifier")) != auth.challenge:
+		http.Error(w, `{"error":"invalid_grant","error_description":"PKCE verification failed"}`, http.StatusBadRequest)
+		return
+	case r.PostForm.Get("redirect_uri") != auth.redirectURI || r.PostForm.Get("client_id") != testClientID:
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusBadRequest)
+		return
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            idp.server.URL,
+		"aud":            testClientID,
+		"sub":            auth.identity.Subject,
+		"email":          auth.identity.Email,
+		"email_verified": auth.identity.EmailVerified,
+		"nonce":          nonce,
+		"exp":            time.Now().Add(time.Minute).Unix(),
+	})
+	idToken.Header["kid"] = "test"
+	signed, err := idToken.SignedString(idp.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"id_token": signed, "token_type": "Bearer"})
+}
+
+func setupOIDCTest(t *testing.T) (*fakeIdP, *gin.Engine) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.NewString())), &gorm.Config{TranslateError: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.AutoMigrate(&models.User{}, &models.Role{}, &models.UserIdentity{}, &models.OAuthState{}, &models.Session{},
+		&models.RefreshToken{}, &models.PersonalAccessToken{}, &models.UserToken{}, &models.RecoveryCode{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	previousDB := database.DB
+	database.DB = db
+	t.Cleanup(func() { database.DB = previousDB })
+
+	if err := utils.InitKeyManager(); err != nil {
+		t.Fatal(err)
+	}
+
+	idp := newFakeIdP(t)
+	t.Setenv("OIDC_ISSUER_URL", idp.server.URL)
+	t.Setenv("OIDC_CLIENT_ID", testClientID)
+	t.Setenv("OIDC_REDIRECT_URL", "http://blog.test/auth/oidc/callback")
+	oidc.InitProvider()
+	t.Cleanup(func() { oidc.Default = nil })
+
+	router := gin.New()
+	router.GET("/auth/oidc/login", OIDCLogin)
+	router.GET("/auth/oidc/callback", OIDCCallback)
+	return idp, router
+}
+
+// startLogin girişi başlatır; sağlayıcının adresini ve tarayıcıya verilen
+// state çerezini döner.
+func startLogin(t *testing.T, router *gin.Engine) (string, *http.Cookie) {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil))
+	if recorder.Code != http.StatusFound {
+		t.Fatalf("login returned %d: %s", recorder.Code, recorder.Body)
+	}
+	for _, cookie := range recorder.Result().Cookies() {
+		if cookie.Name == oidcStateCookie {
+			if !cookie.HttpOnly || cookie.SameSite != http.SameSiteLaxMode {
+				t.Fatalf("state cookie must be HttpOnly and SameSite=Lax: %+v", cookie)
+			}
+			return recorder.Header().Get("Location"), cookie
+		}
+	}
+	t.Fatal("login did not set a state cookie")
+	return "", nil
+}
+
+func callback(router *gin.Engine, code, state string, cookie *http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?"+url.Values{"code": {code}, "state": {state}}.Encode(), nil)
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// login tam bir giriş akışı yürütür.
+func login(t *testing.T, idp *fakeIdP, router *gin.Engine, identity idpIdentity) *httptest.ResponseRecorder {
+	t.Helper()
+	authURL, cookie := startLogin(t, router)
+	code, state := idp.authorize(t, authURL, identity)
+	return callback(router, code, state, cookie)
+}
+
+func createLocalUser(t *testing.T, email string, verified bool) models.User {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("attacker-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := models.User{
+		ID:            uuid.New(),
+		Username:      strings.Split(email, "@")[0],
+		Email:         email,
+		Password:      string(hash),
+		EmailVerified: verified,
+	}
+	if err := database.DB.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func reloadUser(t *testing.T, id uuid.UUID) models.User {
+	t.Helper()
+	var user models.User
+	if err := database.DB.First(&user, "id = ?", id).Error; err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func TestOIDCLoginCreatesUser(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+
+	recorder := login(t, idp, router, idpIdentity{Subject: "sub-1", Email: "new@example.com", EmailVerified: true})
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("callback returned %d: %s", recorder.Code, recorder.Body)
+	}
+
+	var user models.User
+	if err := database.DB.Where("email = ?", "new@example.com").First(&user).Error; err != nil {
+		t.Fatalf("user was not created: %v", err)
+	}
+	if !user.EmailVerified || user.Password != "" {
+		t.Fatalf("OIDC user should be verified and have no password: %+v", user)
+	}
+	var identities int64
+	database.DB.Model(&models.UserIdentity{}).Where("user_id = ? AND subject = ?", user.ID, "sub-1").Count(&identities)
+	if identities != 1 {
+		t.Fatalf("expected a linked identity, got %d", identities)
+	}
+}
+
+func TestOIDCCallbackRequiresStateCookie(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	authURL, cookie := startLogin(t, router)
+	code, state := idp.authorize(t, authURL, idpIdentity{Subject: "sub-1", Email: "a@example.com", EmailVerified: true})
+
+	if recorder := callback(router, code, state, nil); recorder.Code != http.StatusBadRequest {
+		t.Fatalf("callback without state cookie returned %d", recorder.Code)
+	}
+	other := &http.Cookie{Name: oidcStateCookie, Value: "attacker-state"}
+	if recorder := callback(router, code, state, other); recorder.Code != http.StatusBadRequest {
+		t.Fatalf("callback with another browser's state returned %d", recorder.Code)
+	}
+
+	// Reddedilen denemeler state'i tüketmez; girişi başlatan tarayıcı devam edebilir
+	if recorder := callback(router, code, state, cookie); recorder.Code != http.StatusOK {
+		t.Fatalf("callback from the initiating browser returned %d: %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestOIDCStateIsSingleUse(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	authURL, cookie := startLogin(t, router)
+	identity := idpIdentity{Subject: "sub-1", Email: "a@example.com", EmailVerified: true}
+	code, state := idp.authorize(t, authURL, identity)
+
+	if recorder := callback(router, code, state, cookie); recorder.Code != http.StatusOK {
+		t.Fatalf("first callback returned %d: %s", recorder.Code, recorder.Body)
+	}
+	code, _ = idp.authorize(t, authURL, identity)
+	if recorder := callback(router, code, state, cookie); recorder.Code != http.StatusBadRequest {
+		t.Fatalf("replayed state returned %d", recorder.Code)
+	}
+}
+
+func TestOIDCCallbackRejectsExpiredState(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	authURL, cookie := startLogin(t, router)
+	code, state := idp.authorize(t, authURL, idpIdentity{Subject: "sub-1", Email: "a@example.com", EmailVerified: true})
+
+	database.DB.Model(&models.OAuthState{}).Where("state = ?", state).Update("expires_at", time.Now().Add(-time.Minute))
+	if recorder := callback(router, code, state, cookie); recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expired state returned %d", recorder.Code)
+	}
+}
+
+func TestOIDCCallbackRejectsNonceMismatch(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	idp.nonceOverride = "replayed-nonce"
+
+	recorder := login(t, idp, router, idpIdentity{Subject: "sub-1", Email: "a@example.com", EmailVerified: true})
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("ID token with a foreign nonce returned %d", recorder.Code)
+	}
+}
+
+func TestOIDCCallbackSendsPKCEVerifier(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	authURL, cookie := startLogin(t, router)
+	code, state := idp.authorize(t, authURL, idpIdentity{Subject: "sub-1", Email: "a@example.com", EmailVerified: true})
+
+	// Sağlayıcı yalnızca authorization isteğindeki challenge'a uyan verifier'ı kabul eder
+	database.DB.Model(&models.OAuthState{}).Where("state = ?", state).Update("code_verifier", "wrong-verifier")
+	if recorder := callback(router, code, state, cookie); recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("mismatched PKCE verifier returned %d", recorder.Code)
+	}
+}
+
+func TestOIDCLinksVerifiedLocalAccount(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	local := createLocalUser(t, "owner@example.com", true)
+
+	recorder := login(t, idp, router, idpIdentity{Subject: "sub-owner", Email: "owner@example.com", EmailVerified: true})
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("callback returned %d: %s", recorder.Code, recorder.Body)
+	}
+
+	user := reloadUser(t, local.ID)
+	if user.Password != local.Password {
+		t.Fatal("linking a verified account must keep its password")
+	}
+	var identities int64
+	database.DB.Model(&models.UserIdentity{}).Where("user_id = ?", local.ID).Count(&identities)
+	if identities != 1 {
+		t.Fatalf("expected the identity to be linked to the local account, got %d", identities)
+	}
+}
+
+func TestOIDCClaimsUnverifiedLocalAccount(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+
+	// Saldırgan kurbanın email'iyle önceden hesap açıp oturum ve token almış
+	squatter := createLocalUser(t, "victim@example.com", false)
+	session := models.Session{ID: uuid.New(), UserID: squatter.ID, CreatedAt: time.Now(), LastUsedAt: time.Now()}
+	token := models.PersonalAccessToken{UserID: squatter.ID, Name: "ci", Prefix: "pat", TokenHash: "hash", Scopes: "posts:write"}
+	resetToken := models.UserToken{UserID: squatter.ID, Purpose: models.TokenPurposePasswordReset, TokenHash: "reset", ExpiresAt: time.Now().Add(time.Hour)}
+	recovery := models.RecoveryCode{UserID: squatter.ID, CodeHash: "code"}
+	for _, record := range []interface{}{&session, &token, &resetToken, &recovery} {
+		if err := database.DB.Create(record).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	database.DB.Model(&squatter).Updates(map[string]interface{}{"totp_enabled": true, "totp_secret": "secret"})
+
+	recorder := login(t, idp, router, idpIdentity{Subject: "sub-victim", Email: "victim@example.com", EmailVerified: true})
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("callback returned %d: %s", recorder.Code, recorder.Body)
+	}
+	var body struct {
+		Data struct {
+			MFARequired bool `json:"mfa_required"`
+		} `json:"data"`
+	}
+	json.Unmarshal(recorder.Body.Bytes(), &body)
+	if body.Data.MFARequired {
+		t.Fatal("the squatter's TOTP must not guard the claimed account")
+	}
+
+	user := reloadUser(t, squatter.ID)
+	if !user.EmailVerified || user.Password != "" || user.TOTPEnabled || user.TOTPSecret != "" {
+		t.Fatalf("claimed account must be verified with password and 2FA cleared: %+v", user)
+	}
+	checks := []struct {
+		name  string
+		model interface{}
+		where string
+	}{
+		{"session", &models.Session{}, "id = ? AND revoked_at IS NULL"},
+		{"access token", &models.PersonalAccessToken{}, "id = ? AND revoked_at IS NULL"},
+		{"reset token", &models.UserToken{}, "id = ? AND used_at IS NULL"},
+		{"recovery code", &models.RecoveryCode{}, "id = ?"},
+	}
+	ids := []interface{}{session.ID, token.ID, resetToken.ID, recovery.ID}
+	for i, check := range checks {
+		var count int64
+		database.DB.Model(check.model).Where(check.where, ids[i]).Count(&count)
+		if count != 0 {
+			t.Errorf("squatter's %s is still usable", check.name)
+		}
+	}
+}
+
+func TestOIDCRejectsUnverifiedProviderEmail(t *testing.T) {
+	idp, router := setupOIDCTest(t)
+	local := createLocalUser(t, "owner@example.com", true)
+
+	recorder := login(t, idp, router, idpIdentity{Subject: "sub-other", Email: "owner@example.com", EmailVerified: false})
+	if recorder.Code != http.StatusForbidden {
+		t.Fatalf("unverified provider email returned %d", recorder.Code)
+	}
+	var identities int64
+	database.DB.Model(&models.UserIdentity{}).Where("user_id = ?", local.ID).Count(&identities)
+	if identities != 0 {
+		t.Fatal("an unverified provider email must not be linked to an existing account")
+	}
+}
@@ -0,0 +1,29 @@
+package controllers
+
+import (
+	"blog-platform/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// timeSortKey ?sort=newest|oldest parametresini tablonun created_at sütununa göre sıralamaya çevirir.
+func timeSortKey(c *gin.Context, table, fallback string) (utils.SortKey, error) {
+	switch c.DefaultQuery("sort", fallback) {
+	case "newest":
+		return utils.SortKey{Expr: table + ".created_at", Desc: true, IsTime: true}, nil
+	case "oldest":
+		return utils.SortKey{Expr: table + ".created_at", IsTime: true}, nil
+	}
+	return utils.SortKey{}, errors.New("sort must be newest or oldest")
+}
+
+// respondListError geçersiz cursor için 400, diğer hatalar için 500 döner.
+func respondListError(c *gin.Context, err error, message string) {
+	if errors.Is(err, utils.ErrInvalidCursor) {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.CreateResponse(c, http.StatusInternalServerError, message, nil)
+}
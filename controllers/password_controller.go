@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/mailer"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/utils"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// ForgotPassword godoc
+// @Summary Şifre sıfırlama bağlantısı iste
+// @Description Email kayıtlıysa tek kullanımlık, süreli bir şifre sıfırlama bağlantısı gönderir. Hangi adreslerin kayıtlı olduğunu sızdırmamak için yanıt her durumda aynıdır.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body requests.ForgotPasswordRequest true "Email adresi"
+// @Success 200 {object} responses.MessageResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Router /users/password/forgot [post]
+func ForgotPassword(c *gin.Context) {
+	var input requests.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	const message = "If the email is registered, a password reset link has been sent"
+
+	var user models.User
+	if err := database.DB.Where("email = ?", normalizeEmail(input.Email)).First(&user).Error; err != nil {
+		utils.CreateResponse(c, http.StatusOK, message, nil)
+		return
+	}
+
+	ttl := utils.GetEnvDuration("PASSWORD_RESET_TTL", time.Hour)
+	token, err := createUserToken(database.DB, user.ID, models.TokenPurposePasswordReset, ttl)
+	if err != nil {
+		log.Printf("Could not create password reset token for %s: %v", user.ID, err)
+		utils.CreateResponse(c, http.StatusOK, message, nil)
+		return
+	}
+
+	// Yanıt süresinden adresin kayıtlı olup olmadığı anlaşılmasın diye e-posta arka planda gönderilir
+	go func() {
+		link := appURL("/reset-password?token=" + url.QueryEscape(token))
+		err := mailer.Send(mailer.Message{
+			To:      user.Email,
+			Subject: "Reset your password",
+			Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %s and can only be used once.\n\n%s\n\nToken: %s\n\nIf you did not request this, you can ignore this email.\n",
+				user.Username, ttl, link, token),
+		})
+		if err != nil {
+			log.Printf("Could not send password reset email: %v", err)
+		}
+	}()
+
+	utils.CreateResponse(c, http.StatusOK, message, nil)
+}
+
+// ResetPassword godoc
+// @Summary Şifreyi sıfırla
+// @Description Şifre sıfırlama token'ı ile yeni şifre belirler ve kullanıcının tüm oturumlarını kapatır
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body requests.ResetPasswordRequest true "Token ve yeni şifre"
+// @Success 200 {object} responses.MessageResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veya süresi dolmuş token"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/password/reset [post]
+func ResetPassword(c *gin.Context) {
+	var input requests.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not hash password", nil)
+		return
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		record, err := consumeUserToken(tx, input.Token, models.TokenPurposePasswordReset)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.User{}).Where("id = ?", record.UserID).Update("password", string(hashedPassword)).Error; err != nil {
+			return err
+		}
+
+		return revokeUserSessions(tx, record.UserID)
+	})
+	if errors.Is(err, errInvalidUserToken) {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid or expired reset token", nil)
+		return
+	}
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not reset password", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Password has been reset successfully", nil)
+}
@@ -2,85 +2,265 @@ package controllers
 
 import (
 	"blog-platform/database"
+	"blog-platform/events"
 	"blog-platform/models"
 	"blog-platform/requests"
 	"blog-platform/responses"
+	"blog-platform/search"
 	"blog-platform/utils"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// postSortKeys ?sort= parametresinin alabileceği değerlerdir.
+var postSortKeys = map[string]utils.SortKey{
+	"newest":         {Expr: "posts.created_at", Desc: true, IsTime: true},
+	"oldest":         {Expr: "posts.created_at", IsTime: true},
+	"most_reacted":   {Expr: "(SELECT COUNT(*) FROM reactions WHERE reactions.post_id = posts.id)", Desc: true, IsNumber: true},
+	"most_commented": {Expr: "(SELECT COUNT(*) FROM comments WHERE comments.post_id = posts.id AND comments.status = 'approved' AND comments.deleted_at IS NULL)", Desc: true, IsNumber: true},
+}
+
+// postListRow sayıma dayalı sıralamalarda sıralama değerini de taşıyan liste satırıdır.
+type postListRow struct {
+	models.Post
+	SortValue int64 `gorm:"column:sort_value;->"`
+}
+
 // GetPosts godoc
-// @Summary Tüm postları getir
-// @Description Tüm postları listeler
+// @Summary Postları listele
+// @Description Yayımlanmış postları sayfalı olarak listeler; Editor ve Admin status ile diğer durumları (örn. inceleme kuyruğu için in_review) listeleyebilir. page/limit veya önceki yanıttaki next_cursor ile cursor tabanlı sayfalama desteklenir; yazar, kategori ve tarih aralığına göre filtrelenip sıralanabilir.
 // @Tags Post
 // @Produce json
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param author query string false "Yazarın kullanıcı adı"
+// @Param author_id query string false "Yazarın ID'si"
+// @Param category_id query int false "Kategori ID"
+// @Param tag query string false "Etiket slug'ı"
+// @Param from query string false "Bu tarihten sonra oluşturulanlar (RFC3339 veya YYYY-MM-DD)"
+// @Param to query string false "Bu tarihten önce oluşturulanlar (RFC3339 veya YYYY-MM-DD, gün dahil)"
+// @Param sort query string false "Sıralama" Enums(newest, oldest, most_reacted, most_commented)
+// @Param status query string false "Durum (varsayılan published)" Enums(draft, in_review, scheduled, published, archived)
 // @Success 200 {object} responses.PostsResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
+// @Failure 403 {object} responses.ErrorResponse "Yayımlanmamış postları yalnızca editörler listeleyebilir"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /posts [get]
 func GetPosts(c *gin.Context) {
-	var posts []models.Post
-	if err := database.DB.Find(&posts).Error; err != nil {
+	listPosts(c, database.DB.Model(&models.Post{}), false)
+}
+
+// listPosts verilen temel sorguya istek filtrelerini, sıralamayı ve
+// sayfalamayı uygulayarak postları listeler. ownPosts false ise status
+// verilmediğinde yalnızca yayımlanmış postlar listelenir ve diğer durumları
+// yalnızca editörler isteyebilir.
+func listPosts(c *gin.Context, query *gorm.DB, ownPosts bool) {
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	sortKey, ok := postSortKeys[c.DefaultQuery("sort", "newest")]
+	if !ok {
+		utils.CreateResponse(c, http.StatusBadRequest, "sort must be one of newest, oldest, most_reacted, most_commented", nil)
+		return
+	}
+
+	query, err = filterPosts(c, query)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	status := c.Query("status")
+	switch {
+	case status != "" && !models.IsValidPostStatus(status):
+		utils.CreateResponse(c, http.StatusBadRequest, "status must be one of "+strings.Join(models.PostStatuses, ", "), nil)
+		return
+	case status != "":
+		user := optionalUser(c)
+		if !ownPosts && status != models.PostStatusPublished && (user == nil || !isEditor(*user)) {
+			utils.CreateResponse(c, http.StatusForbidden, "Only editors can list unpublished posts", nil)
+			return
+		}
+		query = query.Where("posts.status = ?", status)
+	case !ownPosts:
+		query = query.Where("posts.status = ?", models.PostStatusPublished)
+	}
+
+	sortValue := sortKey.Expr
+	if sortKey.IsTime {
+		sortValue = "0" // Zaman sıralamasında cursor değeri CreatedAt'ten alınır
+	}
+	query = query.Select("posts.*, " + sortValue + " AS sort_value")
+
+	rows, meta, err := utils.Paginate(c, pagination, query, sortKey, "posts.id", func(row postListRow) (interface{}, uint) {
+		if sortKey.IsTime {
+			return row.CreatedAt, row.ID
+		}
+		return row.SortValue, row.ID
+	})
+	if err != nil {
+		respondListError(c, err, "Could not retrieve posts")
+		return
+	}
+
+	posts := make([]models.Post, 0, len(rows))
+	for _, row := range rows {
+		posts = append(posts, row.Post)
+	}
+	if err := loadPostTaxonomies(posts); err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve posts", nil)
 		return
 	}
 
-	var responsePosts []responses.PostResponse
+	responsePosts := make([]responses.PostResponse, 0, len(posts))
 	for _, post := range posts {
-		responsePosts = append(responsePosts, responses.PostResponse{
-			ID:        post.ID,
-			Title:     post.Title,
-			Content:   post.Content,
-			AuthorID:  post.AuthorID,
-			CreatedAt: post.CreatedAt,
-			UpdatedAt: post.UpdatedAt,
-		})
+		responsePosts = append(responsePosts, buildPostResponse(post))
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Posts retrieved successfully", responses.PostsResponse{Posts: responsePosts}, meta)
+}
+
+// loadPostTaxonomies listelenen postların kategorilerini ve etiketlerini toplu
+// yükler. postListRow gömülü Post'u taşıdığından Preload join tablosunu çözemez.
+func loadPostTaxonomies(posts []models.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+	}
+
+	var loaded []models.Post
+	if err := database.DB.Preload("Categories").Preload("Tags").Select("id").Where("id IN ?", ids).Find(&loaded).Error; err != nil {
+		return err
+	}
+
+	byID := make(map[uint]models.Post, len(loaded))
+	for _, post := range loaded {
+		byID[post.ID] = post
 	}
+	for i := range posts {
+		posts[i].Categories = byID[posts[i].ID].Categories
+		posts[i].Tags = byID[posts[i].ID].Tags
+	}
+	return nil
+}
+
+// reloadPostTaxonomies tek bir postun kategorilerini ve etiketlerini yeniden okur.
+func reloadPostTaxonomies(post *models.Post) error {
+	if err := database.DB.Model(post).Association("Categories").Find(&post.Categories); err != nil {
+		return err
+	}
+	return database.DB.Model(post).Association("Tags").Find(&post.Tags)
+}
+
+// filterPosts listeleme filtrelerini sorguya uygular.
+func filterPosts(c *gin.Context, query *gorm.DB) (*gorm.DB, error) {
+	if author := c.Query("author"); author != "" {
+		query = query.Where("posts.author_id IN (?)", database.DB.Model(&models.User{}).Select("id").Where("username = ?", author))
+	}
+	if authorID := c.Query("author_id"); authorID != "" {
+		parsed, err := uuid.Parse(authorID)
+		if err != nil {
+			return nil, errors.New("author_id must be a valid UUID")
+		}
+		query = query.Where("posts.author_id = ?", parsed)
+	}
+	if categoryID := c.Query("category_id"); categoryID != "" {
+		id, err := strconv.ParseUint(categoryID, 10, 64)
+		if err != nil {
+			return nil, errors.New("category_id must be a positive integer")
+		}
+		query = query.Where("posts.id IN (SELECT post_id FROM post_categories WHERE category_id = ?)", id)
+	}
+	if tag := c.Query("tag"); tag != "" {
+		query = query.Where("posts.id IN (SELECT post_tags.post_id FROM post_tags JOIN tags ON tags.id = post_tags.tag_id WHERE tags.slug = ?)", utils.Slugify(tag))
+	}
+	if from := c.Query("from"); from != "" {
+		start, _, err := parseDateParam(from)
+		if err != nil {
+			return nil, errors.New("from must be an RFC3339 timestamp or YYYY-MM-DD date")
+		}
+		query = query.Where("posts.created_at >= ?", start)
+	}
+	if to := c.Query("to"); to != "" {
+		end, dateOnly, err := parseDateParam(to)
+		if err != nil {
+			return nil, errors.New("to must be an RFC3339 timestamp or YYYY-MM-DD date")
+		}
+		// Yalnızca tarih verilmişse o günün tamamı dahil edilir
+		if dateOnly {
+			end = end.AddDate(0, 0, 1)
+			query = query.Where("posts.created_at < ?", end)
+		} else {
+			query = query.Where("posts.created_at <= ?", end)
+		}
+	}
+	return query, nil
+}
 
-	utils.CreateResponse(c, http.StatusOK, "Posts retrieved successfully", responses.PostsResponse{Posts: responsePosts})
+// parseDateParam RFC3339 zaman damgası veya YYYY-MM-DD tarihi kabul eder.
+func parseDateParam(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	return t, true, err
 }
 
 // GetPost godoc
 // @Summary Belirli bir postu getir
-// @Description ID ile tek bir postu getirir
+// @Description ID ile tek bir postu getirir. Yayımlanmamış postları yalnızca yazarı, Editor ve Admin görebilir.
 // @Tags Post
 // @Produce json
 // @Param post_id path int true "Post ID"
+// @Param If-None-Match header string false "Önceki yanıttaki ETag; post değişmediyse 304 döner"
 // @Success 200 {object} responses.PostResponse
+// @Header 200 {string} ETag "Postun sürümü"
+// @Success 304 "Post değişmedi"
 // @Failure 404 {object} responses.ErrorResponse "Post bulunamadı"
 // @Router /posts/{post_id} [get]
 func GetPost(c *gin.Context) {
 	postID := c.Param("post_id")
 
 	var post models.Post
-	if err := database.DB.Where("id = ?", postID).First(&post).Error; err != nil {
+	if err := database.DB.Preload("Categories").Preload("Tags").Where("id = ?", postID).First(&post).Error; err != nil || !canViewPost(post, optionalUser(c)) {
 		utils.CreateResponse(c, http.StatusNotFound, "Post not found", nil)
 		return
 	}
-
-	responsePost := responses.PostResponse{
-		ID:        post.ID,
-		Title:     post.Title,
-		Content:   post.Content,
-		AuthorID:  post.AuthorID,
-		CreatedAt: post.CreatedAt,
-		UpdatedAt: post.UpdatedAt,
+	if utils.NotModified(c, post.Version) {
+		return
 	}
 
+	responsePost := buildPostResponse(post)
+
 	utils.CreateResponse(c, http.StatusOK, "Post retrieved successfully", responsePost)
 }
 
 // CreatePost godoc
 // @Summary Yeni bir post oluştur
-// @Description Yeni bir post oluşturur
+// @Description Yeni bir post oluşturur; category_ids ile kategorilere bağlanabilir. slug verilmezse başlıktan üretilir. Postlar taslak olarak oluşturulur; status=in_review doğrudan incelemeye gönderir (yazarlar için Author rolü gerekir), status=published yalnızca Editor ve Admin için geçerlidir. comment_mode (open, moderated, closed) postun yorum ayarını belirler; verilmezse sitenin ayarı geçerlidir.
 // @Tags Post
 // @Accept json
 // @Produce json
 // @Param post body requests.CreatePostRequest true "Post bilgisi"
 // @Success 200 {object} responses.PostResponse
-// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri veya bilinmeyen kategori"
+// @Failure 403 {object} responses.ErrorResponse "Bu durumla oluşturma yetkisi yok"
+// @Failure 409 {object} responses.ErrorResponse "Slug başka bir post tarafından kullanılıyor"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /posts [post]
 func CreatePost(c *gin.Context) {
@@ -96,41 +276,99 @@ func CreatePost(c *gin.Context) {
 		return
 	}
 
-	post := models.Post{
-		Title:    input.Title,
-		Content:  input.Content,
-		AuthorID: user.(models.User).ID,
+	currentUser := user.(models.User)
+
+	categories, err := findCategories(input.CategoryIDs)
+	if err != nil {
+		respondCategoryError(c, err)
+		return
 	}
 
-	if err := database.DB.Create(&post).Error; err != nil {
-		utils.CreateResponse(c, http.StatusInternalServerError, "Could not create post", nil)
+	post := models.Post{
+		Title:         input.Title,
+		Content:       input.Content,
+		ContentFormat: contentFormatOrDefault(input.ContentFormat),
+		AuthorID:      currentUser.ID,
+		Status:        models.PostStatusDraft,
+		CommentMode:   input.CommentMode,
+		Categories:    categories,
+	}
+	if err := renderPostContent(&post); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not render post content", nil)
 		return
 	}
 
-	responsePost := responses.PostResponse{
-		ID:        post.ID,
-		Title:     post.Title,
-		Content:   post.Content,
-		AuthorID:  post.AuthorID,
-		CreatedAt: post.CreatedAt,
-		UpdatedAt: post.UpdatedAt,
+	// İstenen ilk durum, taslaktan yapılan normal bir geçiş gibi denetlenir
+	if input.Status != "" && input.Status != models.PostStatusDraft {
+		if err := checkPostTransition(post, currentUser, input.Status, ""); err != nil {
+			respondWorkflowError(c, err, "Could not create post")
+			return
+		}
 	}
 
+	var change models.PostStatusChange
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if post.Slug, err = resolvePostSlug(tx, input.Slug, post.Title, 0); err != nil {
+			return err
+		}
+		if post.Tags, err = resolveTags(tx, input.Tags); err != nil {
+			return err
+		}
+		// Kategoriler ve etiketler zaten var; yalnızca join kayıtları eklenir
+		if err := tx.Omit("Categories.*", "Tags.*").Create(&post).Error; err != nil {
+			return err
+		}
+		if err := claimPostSlug(tx, post.ID, "", post.Slug); err != nil {
+			return err
+		}
+		if err := recordPostRevision(tx, post, currentUser, ""); err != nil {
+			return err
+		}
+		if err := search.IndexPost(tx, post); err != nil {
+			return err
+		}
+		if input.Status == "" || input.Status == models.PostStatusDraft {
+			return nil
+		}
+		change, err = applyPostTransition(tx, &post, input.Status, currentUser, "", postSchedule{})
+		return err
+	})
+	if errors.Is(err, errInvalidTag) {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if err != nil {
+		respondPostSlugError(c, err, "Could not create post")
+		return
+	}
+	if change.ID != 0 {
+		publishPostEvent(post, change)
+	}
+
+	responsePost := buildPostResponse(post)
+
+	utils.SetVersionETag(c, post.Version)
 	utils.CreateResponse(c, http.StatusOK, "Post created successfully", responsePost)
 }
 
 // UpdatePost godoc
 // @Summary Mevcut bir postu güncelle
-// @Description ID ile mevcut bir postu günceller
+// @Description ID ile mevcut bir postu günceller; yazar dışında Editor ve Admin de güncelleyebilir. category_ids gönderilirse postun kategorileri bu listeyle değiştirilir. slug değiştirilirse eski slug yeni adrese yönlendirilir. comment_mode yorumları açar, onaya bağlar veya kapatır; boş değer sitenin ayarına döner. İstemci gördüğü sürümü If-Match başlığıyla (veya version alanıyla) göndermelidir; post bu arada değiştiyse 412 ile güncel hali döner.
 // @Tags Post
 // @Accept json
 // @Produce json
 // @Param post_id path int true "Post ID"
+// @Param If-Match header string false "GetPost yanıtındaki ETag, ör. \"3\""
 // @Param post body requests.UpdatePostRequest true "Güncellenecek post bilgisi"
 // @Success 200 {object} responses.PostResponse
-// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Header 200 {string} ETag "Postun yeni sürümü"
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri veya bilinmeyen kategori"
 // @Failure 403 {object} responses.ErrorResponse "Yetkisiz erişim"
 // @Failure 404 {object} responses.ErrorResponse "Post bulunamadı"
+// @Failure 409 {object} responses.ErrorResponse "Slug başka bir post tarafından kullanılıyor"
+// @Failure 412 {object} responses.PostResponse "Post başka biri tarafından değiştirildi; güncel hali döner"
+// @Failure 428 {object} responses.ErrorResponse "If-Match veya version gerekli"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /posts/{post_id} [put]
 func UpdatePost(c *gin.Context) {
@@ -153,7 +391,7 @@ func UpdatePost(c *gin.Context) {
 		return
 	}
 
-	if post.AuthorID != currentUser.ID {
+	if post.AuthorID != currentUser.ID && !isEditor(currentUser) {
 		utils.CreateResponse(c, http.StatusForbidden, "You are not allowed to update this post", nil)
 		return
 	}
@@ -164,28 +402,135 @@ func UpdatePost(c *gin.Context) {
 		return
 	}
 
+	expected, ok := expectedVersion(c, input.Version)
+	if !ok {
+		return
+	}
+	if !versionMatches(expected, post.Version) {
+		respondPostConflict(c, post.ID)
+		return
+	}
+
+	var categories []models.Category
+	if input.CategoryIDs != nil {
+		if categories, err = findCategories(*input.CategoryIDs); err != nil {
+			respondCategoryError(c, err)
+			return
+		}
+	}
+
+	format := post.ContentFormat
+	if input.ContentFormat != "" {
+		format = input.ContentFormat
+	}
+	contentChanged := post.Content != input.Content || post.ContentFormat != format
+	changed := contentChanged || post.Title != input.Title
+
+	original := post
 	post.Title = input.Title
 	post.Content = input.Content
-	if err := database.DB.Save(&post).Error; err != nil {
+	post.ContentFormat = format
+	if input.CommentMode != nil {
+		post.CommentMode = *input.CommentMode
+	}
+	// HTML yalnızca içerik veya biçim değiştiğinde yeniden üretilir
+	if contentChanged {
+		if err := renderPostContent(&post); err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not render post content", nil)
+			return
+		}
+	}
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		// Slug yalnızca istendiğinde değişir; eski slug yönlendirme olarak kalır
+		if input.Slug != nil {
+			slug, err := resolvePostSlug(tx, *input.Slug, post.Title, post.ID)
+			if err != nil {
+				return err
+			}
+			if slug != original.Slug {
+				if err := claimPostSlug(tx, post.ID, original.Slug, slug); err != nil {
+					return err
+				}
+				post.Slug = slug
+			}
+		}
+		// Yalnızca başlık veya içerik değiştiğinde yeni sürüm kaydedilir
+		if changed {
+			if err := ensurePostBaseRevision(tx, original); err != nil {
+				return err
+			}
+		}
+		// Okunan sürüm hâlâ güncelse yazılır; arada başka bir istek yazdıysa hiçbir satır değişmez
+		result := tx.Model(&post).Where("version = ?", original.Version).
+			Updates(map[string]interface{}{
+				"title":          post.Title,
+				"slug":           post.Slug,
+				"content":        post.Content,
+				"content_format": post.ContentFormat,
+				"content_html":   post.ContentHTML,
+				"comment_mode":   post.CommentMode,
+				"version":        bumpVersion,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errVersionConflict
+		}
+		post.Version++
+		if changed {
+			if err := recordPostRevision(tx, post, currentUser, input.ChangeNote); err != nil {
+				return err
+			}
+			if err := search.IndexPost(tx, post); err != nil {
+				return err
+			}
+		}
+		if input.Tags != nil {
+			tags, err := resolveTags(tx, *input.Tags)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&post).Omit("Tags.*").Association("Tags").Replace(tags); err != nil {
+				return err
+			}
+		}
+		if input.CategoryIDs != nil {
+			return tx.Model(&post).Omit("Categories.*").Association("Categories").Replace(categories)
+		}
+		return nil
+	})
+	if errors.Is(err, errVersionConflict) {
+		respondPostConflict(c, post.ID)
+		return
+	}
+	if errors.Is(err, errInvalidTag) {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if err != nil {
+		respondPostSlugError(c, err, "Could not update post")
+		return
+	}
+
+	if err := reloadPostTaxonomies(&post); err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not update post", nil)
 		return
 	}
 
-	responsePost := responses.PostResponse{
-		ID:        post.ID,
-		Title:     post.Title,
-		Content:   post.Content,
-		AuthorID:  post.AuthorID,
-		CreatedAt: post.CreatedAt,
-		UpdatedAt: post.UpdatedAt,
+	if post.Status == models.PostStatusPublished {
+		events.PublishContentChanged()
 	}
 
+	responsePost := buildPostResponse(post)
+
+	utils.SetVersionETag(c, post.Version)
 	utils.CreateResponse(c, http.StatusOK, "Post updated successfully", responsePost)
 }
 
 // RemovePost godoc
 // @Summary Mevcut bir postu sil
-// @Description ID ile mevcut bir postu siler
+// @Description ID ile mevcut bir postu siler; yazar veya Admin silebilir
 // @Tags Post
 // @Produce json
 // @Param post_id path int true "Post ID"
@@ -215,15 +560,57 @@ func RemovePost(c *gin.Context) {
 		return
 	}
 
-	if post.AuthorID != currentUser.ID {
+	if post.AuthorID != currentUser.ID && !utils.HasRole(currentUser, "Admin") {
 		utils.CreateResponse(c, http.StatusForbidden, "You are not allowed to delete this post", nil)
 		return
 	}
 
-	if err := database.DB.Delete(&post).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&post).Error; err != nil {
+			return err
+		}
+		return search.RemovePosts(tx, post.ID)
+	})
+	if err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not delete post", nil)
 		return
 	}
 
+	if post.Status == models.PostStatusPublished {
+		events.PublishContentChanged()
+	}
+
 	utils.CreateResponse(c, http.StatusOK, "Post deleted successfully", nil)
 }
+
+// buildPostResponse postun yanıt modelini üretir; kategoriler önceden yüklenmiş olmalıdır.
+func buildPostResponse(post models.Post) responses.PostResponse {
+	categories := make([]responses.CategoryResponse, 0, len(post.Categories))
+	for _, category := range post.Categories {
+		categories = append(categories, buildCategoryResponse(category))
+	}
+	tags := make([]responses.TagResponse, 0, len(post.Tags))
+	for _, tag := range post.Tags {
+		tags = append(tags, buildTagResponse(tag))
+	}
+
+	return responses.PostResponse{
+		ID:            post.ID,
+		Title:         post.Title,
+		Slug:          post.Slug,
+		Content:       post.Content,
+		ContentFormat: post.ContentFormat,
+		ContentHTML:   post.ContentHTML,
+		AuthorID:      post.AuthorID,
+		Status:        post.Status,
+		PublishedAt:   post.PublishedAt,
+		PublishAt:     post.PublishAt,
+		UnpublishAt:   post.UnpublishAt,
+		Categories:    categories,
+		Tags:          tags,
+		CommentMode:   commentModeFor(post),
+		Version:       post.Version,
+		CreatedAt:     post.CreatedAt,
+		UpdatedAt:     post.UpdatedAt,
+	}
+}
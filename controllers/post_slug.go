@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+var (
+	errInvalidPostSlug = errors.New("Slug must contain at least one letter and may not be purely numeric")
+	errPostSlugTaken   = errors.New("Slug is already used by another post")
+)
+
+// GetPostBySlug godoc
+// @Summary Postu slug ile getir
+// @Description Postu URL slug'ı ile getirir. Slug postun eski bir slug'ı ise güncel adrese 301 ile yönlendirir. Yayımlanmamış postları yalnızca yazarı, Editor ve Admin görebilir.
+// @Tags Post
+// @Produce json
+// @Param slug path string true "Post slug"
+// @Param If-None-Match header string false "Önceki yanıttaki ETag; post değişmediyse 304 döner"
+// @Success 200 {object} responses.PostResponse
+// @Header 200 {string} ETag "Postun sürümü"
+// @Success 301 "Eski slug; Location başlığı güncel adresi verir"
+// @Success 304 "Post değişmedi"
+// @Failure 404 {object} responses.ErrorResponse "Post bulunamadı"
+// @Router /posts/by-slug/{slug} [get]
+func GetPostBySlug(c *gin.Context) {
+	slug := c.Param("slug")
+	viewer := optionalUser(c)
+
+	var post models.Post
+	err := database.DB.Preload("Categories").Preload("Tags").Where("slug = ?", slug).First(&post).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Eski slug'lar postun güncel adresine yönlendirilir
+		var redirect models.PostSlugRedirect
+		if err := database.DB.Where("slug = ?", slug).First(&redirect).Error; err == nil {
+			if err := database.DB.First(&post, redirect.PostID).Error; err == nil && canViewPost(post, viewer) {
+				c.Redirect(http.StatusMovedPermanently, "/posts/by-slug/"+post.Slug)
+				return
+			}
+		}
+	}
+	if err != nil || !canViewPost(post, viewer) {
+		utils.CreateResponse(c, http.StatusNotFound, "Post not found", nil)
+		return
+	}
+	if utils.NotModified(c, post.Version) {
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Post retrieved successfully", buildPostResponse(post))
+}
+
+// resolvePostSlug açıkça istenen slug'ı doğrular ve başka bir post kullanıyorsa
+// hata döner; slug verilmemişse başlıktan benzersiz bir slug üretir. Üretilen
+// slug'lar başka postların eski slug'larıyla da çakışmaz, böylece eski
+// bağlantılar bozulmaz.
+func resolvePostSlug(tx *gorm.DB, requested, title string, excludeID uint) (string, error) {
+	if requested != "" {
+		slug := utils.Slugify(requested)
+		if slug == "" || isNumeric(slug) {
+			return "", errInvalidPostSlug
+		}
+		var count int64
+		if err := tx.Model(&models.Post{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count > 0 {
+			return "", errPostSlugTaken
+		}
+		return slug, nil
+	}
+
+	return utils.UniqueSlugFunc(utils.SlugWithFallback(title, "post"), func(candidate string) (bool, error) {
+		var count int64
+		if err := tx.Model(&models.Post{}).Where("slug = ? AND id <> ?", candidate, excludeID).Count(&count).Error; err != nil || count > 0 {
+			return count > 0, err
+		}
+		err := tx.Model(&models.PostSlugRedirect{}).Where("slug = ? AND post_id <> ?", candidate, excludeID).Count(&count).Error
+		return count > 0, err
+	})
+}
+
+// claimPostSlug slug'ı posta ayırır. Slug başka bir postun eski slug'ı olarak
+// tutuluyorsa yönlendirme kaldırılır, çünkü canlı post önceliklidir. oldSlug
+// verilmişse ileride yönlendirilmek üzere saklanır.
+func claimPostSlug(tx *gorm.DB, postID uint, oldSlug, slug string) error {
+	if err := tx.Where("slug = ?", slug).Delete(&models.PostSlugRedirect{}).Error; err != nil {
+		return err
+	}
+	if oldSlug == "" || oldSlug == slug {
+		return nil
+	}
+	return tx.Create(&models.PostSlugRedirect{Slug: oldSlug, PostID: postID}).Error
+}
+
+// respondPostSlugError slug hatalarını uygun durum koduyla döner.
+func respondPostSlugError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, errInvalidPostSlug):
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+	case errors.Is(err, errPostSlugTaken), errors.Is(err, gorm.ErrDuplicatedKey):
+		utils.CreateResponse(c, http.StatusConflict, errPostSlugTaken.Error(), nil)
+	default:
+		utils.CreateResponse(c, http.StatusInternalServerError, fallback, nil)
+	}
+}
@@ -0,0 +1,376 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/events"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/responses"
+	"blog-platform/utils"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// postTransition bir postun bir durumdan diğerine geçişidir.
+type postTransition struct {
+	from, to string
+}
+
+// transitionPolicy geçişi kimin yapabileceğini belirler. Admin tablodaki
+// kısıtlardan bağımsız olarak herhangi bir geçişi yapabilir.
+type transitionPolicy struct {
+	author bool // Postun yazarı
+	editor bool // Editor rolündeki kullanıcılar
+	// Yazarın geçişi yapabilmesi için Author rolüne de sahip olması gerekir
+	authorRole bool
+	// Başkasının postu üzerinde çalışan editörün not bırakması gerekir
+	editorNote bool
+}
+
+var postTransitions = map[postTransition]transitionPolicy{
+	{models.PostStatusDraft, models.PostStatusInReview}:      {author: true, authorRole: true, editor: true}, // İncelemeye gönderme
+	{models.PostStatusInReview, models.PostStatusDraft}:      {author: true, editor: true, editorNote: true}, // Geri çekme veya reddetme
+	{models.PostStatusInReview, models.PostStatusPublished}:  {editor: true},                                 // Onaylama
+	{models.PostStatusDraft, models.PostStatusPublished}:     {editor: true},
+	{models.PostStatusPublished, models.PostStatusDraft}:     {editor: true, editorNote: true}, // Yayından kaldırma
+	{models.PostStatusPublished, models.PostStatusArchived}:  {author: true, editor: true},
+	{models.PostStatusArchived, models.PostStatusDraft}:      {author: true, editor: true},
+	{models.PostStatusDraft, models.PostStatusScheduled}:     {editor: true},
+	{models.PostStatusInReview, models.PostStatusScheduled}:  {editor: true},
+	{models.PostStatusScheduled, models.PostStatusScheduled}: {editor: true}, // Yeniden zamanlama
+	{models.PostStatusScheduled, models.PostStatusPublished}: {editor: true}, // Hemen yayımlama
+	{models.PostStatusScheduled, models.PostStatusDraft}:     {author: true, editor: true, editorNote: true},
+}
+
+// postSchedule bir geçişte postun zamanlama alanlarına yazılacak değerlerdir.
+type postSchedule struct {
+	publishAt   *time.Time
+	unpublishAt *time.Time
+}
+
+// workflowError yayın akışı hatasını HTTP durum koduyla taşır.
+type workflowError struct {
+	status  int
+	message string
+}
+
+func (e workflowError) Error() string {
+	return e.message
+}
+
+// ChangePostStatus godoc
+// @Summary Postun durumunu değiştir
+// @Description Postu yayın akışında ilerletir. Author rolündeki yazar taslağı incelemeye gönderir, yazar geri çeker veya arşivler; Editor onaylar, not ile reddeder, publish_at ile zamanlar veya yayından kaldırır; Admin her geçişi yapabilir. unpublish_at verilirse post o zaman arşivlenir; verilmezse postun mevcut unpublish_at değeri korunur, clear_unpublish_at ile silinir.
+// @Tags Post
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param status body requests.ChangePostStatusRequest true "Yeni durum ve not"
+// @Success 200 {object} responses.PostResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz durum veya eksik not"
+// @Failure 403 {object} responses.ErrorResponse "Geçiş için yetki yok"
+// @Failure 404 {object} responses.ErrorResponse "Post bulunamadı"
+// @Failure 409 {object} responses.ErrorResponse "Geçiş bu durumdan yapılamaz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /posts/{post_id}/status [post]
+func ChangePostStatus(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	postID, err := strconv.Atoi(c.Param("post_id"))
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid post ID", nil)
+		return
+	}
+
+	var input requests.ChangePostStatusRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var post models.Post
+	var change models.PostStatusChange
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Preload("Categories").Preload("Tags").Where("id = ?", postID).First(&post).Error; err != nil {
+			return err
+		}
+		if !canViewPost(post, &currentUser) {
+			return gorm.ErrRecordNotFound
+		}
+
+		note := strings.TrimSpace(input.Note)
+		if err := checkPostTransition(post, currentUser, input.Status, note); err != nil {
+			return err
+		}
+		schedule, err := resolvePostSchedule(post, input, time.Now())
+		if err != nil {
+			return err
+		}
+		change, err = applyPostTransition(tx, &post, input.Status, currentUser, note, schedule)
+		return err
+	})
+	if err != nil {
+		respondWorkflowError(c, err, "Could not change post status")
+		return
+	}
+
+	publishPostEvent(post, change)
+
+	utils.CreateResponse(c, http.StatusOK, "Post status changed successfully", buildPostResponse(post))
+}
+
+// GetPostStatusHistory godoc
+// @Summary Postun durum geçmişini getir
+// @Description Postun yayın akışındaki tüm durum değişikliklerini ve editör notlarını eskiden yeniye listeler. Yalnızca yazar, Editor ve Admin görebilir.
+// @Tags Post
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} []responses.PostStatusChangeResponse
+// @Failure 403 {object} responses.ErrorResponse "Yetkisiz erişim"
+// @Failure 404 {object} responses.ErrorResponse "Post bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /posts/{post_id}/status-history [get]
+func GetPostStatusHistory(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var post models.Post
+	if err := database.DB.Where("id = ?", c.Param("post_id")).First(&post).Error; err != nil || !canViewPost(post, &currentUser) {
+		utils.CreateResponse(c, http.StatusNotFound, "Post not found", nil)
+		return
+	}
+	if post.AuthorID != currentUser.ID && !isEditor(currentUser) {
+		utils.CreateResponse(c, http.StatusForbidden, "You are not allowed to view this post's history", nil)
+		return
+	}
+
+	var changes []models.PostStatusChange
+	if err := database.DB.Where("post_id = ?", post.ID).Order("created_at, id").Find(&changes).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve status history", nil)
+		return
+	}
+
+	history := make([]responses.PostStatusChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		history = append(history, responses.PostStatusChangeResponse{
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			ActorID:    change.ActorID,
+			Note:       change.Note,
+			CreatedAt:  change.CreatedAt,
+		})
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Status history retrieved successfully", history)
+}
+
+// GetMyPosts godoc
+// @Summary Kendi postlarımı listele
+// @Description Giriş yapmış kullanıcının tüm durumlardaki postlarını listeler; status=draft ile taslaklar getirilir. GET /posts ile aynı sayfalama, filtre ve sıralama parametrelerini kabul eder.
+// @Tags Post
+// @Produce json
+// @Param status query string false "Durum" Enums(draft, in_review, scheduled, published, archived)
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param sort query string false "Sıralama" Enums(newest, oldest, most_reacted, most_commented)
+// @Success 200 {object} responses.PostsResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /posts/mine [get]
+func GetMyPosts(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	listPosts(c, database.DB.Model(&models.Post{}).Where("posts.author_id = ?", user.(models.User).ID), true)
+}
+
+// checkPostTransition kullanıcının postu verilen duruma taşıyıp taşıyamayacağını kontrol eder.
+func checkPostTransition(post models.Post, user models.User, to, note string) error {
+	if !models.IsValidPostStatus(to) {
+		return workflowError{http.StatusBadRequest, "status must be one of " + strings.Join(models.PostStatuses, ", ")}
+	}
+	if post.Status == to && to != models.PostStatusScheduled {
+		return workflowError{http.StatusConflict, "Post is already " + to}
+	}
+	if utils.HasRole(user, "Admin") {
+		return nil
+	}
+
+	policy, ok := postTransitions[postTransition{post.Status, to}]
+	if !ok {
+		return workflowError{http.StatusConflict, fmt.Sprintf("A post cannot move from %s to %s", post.Status, to)}
+	}
+
+	isAuthor := post.AuthorID == user.ID
+	switch {
+	case policy.author && isAuthor && (!policy.authorRole || utils.HasRole(user, "Author")):
+		return nil
+	case policy.editor && utils.HasRole(user, "Editor"):
+		if policy.editorNote && !isAuthor && note == "" {
+			return workflowError{http.StatusBadRequest, "A note is required when moving someone else's post back to draft"}
+		}
+		return nil
+	}
+	return workflowError{http.StatusForbidden, fmt.Sprintf("You are not allowed to move this post from %s to %s", post.Status, to)}
+}
+
+// resolvePostSchedule geçişle birlikte gönderilen zamanlama alanlarını doğrular.
+// publish_at yalnızca zamanlarken ve gelecekte olmalıdır; unpublish_at yalnızca
+// zamanlanan veya yayımlanan postlar için geçerlidir ve yayın zamanından sonra olmalıdır.
+// unpublish_at gönderilmezse postun mevcut değeri korunur; clear_unpublish_at ile
+// silinir. Post yeniden yayına girerken süresi çoktan geçmiş bir değer atılır.
+func resolvePostSchedule(post models.Post, input requests.ChangePostStatusRequest, now time.Time) (postSchedule, error) {
+	to, publishAt := input.Status, input.PublishAt
+	if to == models.PostStatusScheduled {
+		if publishAt == nil {
+			return postSchedule{}, workflowError{http.StatusBadRequest, "publish_at is required to schedule a post"}
+		}
+		if !publishAt.After(now) {
+			return postSchedule{}, workflowError{http.StatusBadRequest, "publish_at must be in the future"}
+		}
+	} else if publishAt != nil {
+		return postSchedule{}, workflowError{http.StatusBadRequest, "publish_at can only be set when scheduling a post"}
+	}
+
+	goesLive := now
+	if publishAt != nil {
+		goesLive = *publishAt
+	}
+	live := to == models.PostStatusScheduled || to == models.PostStatusPublished
+
+	unpublishAt := input.UnpublishAt
+	switch {
+	case unpublishAt != nil && input.ClearUnpublishAt:
+		return postSchedule{}, workflowError{http.StatusBadRequest, "unpublish_at cannot be combined with clear_unpublish_at"}
+	case unpublishAt != nil:
+		if !live {
+			return postSchedule{}, workflowError{http.StatusBadRequest, "unpublish_at can only be set when scheduling or publishing a post"}
+		}
+		if !unpublishAt.After(goesLive) {
+			return postSchedule{}, workflowError{http.StatusBadRequest, "unpublish_at must be after the post goes live"}
+		}
+	case input.ClearUnpublishAt:
+		unpublishAt = nil
+	default:
+		unpublishAt = post.UnpublishAt
+		if unpublishAt != nil && live {
+			if !unpublishAt.After(now) {
+				unpublishAt = nil
+			} else if !unpublishAt.After(goesLive) {
+				return postSchedule{}, workflowError{http.StatusBadRequest, "The post's unpublish_at is before the new publish_at; send a later unpublish_at or clear_unpublish_at"}
+			}
+		}
+	}
+
+	return postSchedule{publishAt: publishAt, unpublishAt: unpublishAt}, nil
+}
+
+// applyPostTransition durumu ve zamanlama alanlarını günceller, değişikliği
+// geçmişe yazar. İlk kez yayımlanan postun yayın zamanı kaydedilir.
+func applyPostTransition(tx *gorm.DB, post *models.Post, to string, actor models.User, note string, schedule postSchedule) (models.PostStatusChange, error) {
+	change := models.PostStatusChange{
+		PostID:     post.ID,
+		FromStatus: post.Status,
+		ToStatus:   to,
+		ActorID:    actor.ID,
+		Note:       note,
+	}
+
+	updates := map[string]interface{}{
+		"status":       to,
+		"publish_at":   schedule.publishAt,
+		"unpublish_at": schedule.unpublishAt,
+		"version":      bumpVersion,
+	}
+	if to == models.PostStatusPublished && post.PublishedAt == nil {
+		now := time.Now()
+		post.PublishedAt = &now
+		updates["published_at"] = now
+	}
+	if err := tx.Model(post).Updates(updates).Error; err != nil {
+		return change, err
+	}
+	post.Status = to
+	post.Version++
+	post.PublishAt = schedule.publishAt
+	post.UnpublishAt = schedule.unpublishAt
+
+	return change, tx.Create(&change).Error
+}
+
+// publishPostEvent transaction tamamlandıktan sonra geçişe ait post olayını yayınlar.
+func publishPostEvent(post models.Post, change models.PostStatusChange) {
+	events.PublishPostStatusChange(events.PostStatusChanged{
+		PostID:     post.ID,
+		AuthorID:   post.AuthorID,
+		ActorID:    change.ActorID,
+		FromStatus: change.FromStatus,
+		ToStatus:   change.ToStatus,
+		At:         change.CreatedAt,
+	})
+}
+
+// respondWorkflowError yayın akışı hatalarını kendi durum koduyla, bulunamayan
+// postları 404, diğer hataları 500 olarak döner.
+func respondWorkflowError(c *gin.Context, err error, message string) {
+	var workflowErr workflowError
+	switch {
+	case errors.As(err, &workflowErr):
+		utils.CreateResponse(c, workflowErr.status, workflowErr.message, nil)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		utils.CreateResponse(c, http.StatusNotFound, "Post not found", nil)
+	default:
+		utils.CreateResponse(c, http.StatusInternalServerError, message, nil)
+	}
+}
+
+// optionalUser isteğe bağlı kimlik doğrulamalı uçlarda giriş yapmış kullanıcıyı döner.
+func optionalUser(c *gin.Context) *models.User {
+	user, exists := c.Get("user")
+	if !exists {
+		return nil
+	}
+	currentUser := user.(models.User)
+	return &currentUser
+}
+
+// isEditor kullanıcının başkalarının postlarını inceleyip düzenleyebileceğini belirtir.
+func isEditor(user models.User) bool {
+	return utils.HasRole(user, "Editor") || utils.HasRole(user, "Admin")
+}
+
+// canViewPost yayımlanmış postları herkese, diğerlerini yalnızca yazara ve editörlere gösterir.
+func canViewPost(post models.Post, user *models.User) bool {
+	if post.Status == models.PostStatusPublished {
+		return true
+	}
+	return user != nil && (post.AuthorID == user.ID || isEditor(*user))
+}
+
+// findPublishedPost yorum ve reaction eklenebilecek yayımlanmış postu bulur.
+func findPublishedPost(postID interface{}) (models.Post, error) {
+	var post models.Post
+	err := database.DB.Where("id = ? AND status = ?", postID, models.PostStatusPublished).First(&post).Error
+	return post, err
+}
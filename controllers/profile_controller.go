@@ -0,0 +1,380 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/events"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/responses"
+	"blog-platform/utils"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	avatarMaxBytes   = 2 << 20
+	profilePostLimit = 20
+)
+
+var (
+	usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,30}$`)
+
+	// /users/me gibi statik yollarla çakışan kullanıcı adları
+	reservedUsernames = map[string]bool{"me": true, models.DeletedUsername: true}
+
+	avatarExtensions = map[string]string{
+		"image/png":  ".png",
+		"image/jpeg": ".jpg",
+		"image/gif":  ".gif",
+		"image/webp": ".webp",
+	}
+
+	errInvalidUsername = errors.New("username must be 3-30 characters of letters, digits, '.', '_' or '-'")
+)
+
+// validateUsername kullanıcı adının biçimini ve ayrılmış adları kontrol eder.
+func validateUsername(username string) error {
+	if !usernamePattern.MatchString(username) || reservedUsernames[strings.ToLower(username)] {
+		return errInvalidUsername
+	}
+	return nil
+}
+
+// GetMyProfile godoc
+// @Summary Kendi profilini getir
+// @Description Giriş yapmış kullanıcının profil bilgilerini ve rollerini döner
+// @Tags User
+// @Produce json
+// @Success 200 {object} responses.ProfileResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Router /users/me [get]
+func GetMyProfile(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Profile retrieved successfully", buildProfileResponse(user.(models.User)))
+}
+
+// UpdateMyProfile godoc
+// @Summary Profili güncelle
+// @Description Ad, soyad, kullanıcı adı, biyografi ve web sitesi alanlarından gönderilenleri günceller
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param profile body requests.UpdateProfileRequest true "Güncellenecek alanlar"
+// @Success 200 {object} responses.ProfileResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 409 {object} responses.ErrorResponse "Kullanıcı adı kullanımda"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me [patch]
+func UpdateMyProfile(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var input requests.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	updates := map[string]interface{}{}
+	if input.FirstName != nil {
+		updates["first_name"] = strings.TrimSpace(*input.FirstName)
+	}
+	if input.LastName != nil {
+		updates["last_name"] = strings.TrimSpace(*input.LastName)
+	}
+	if input.Bio != nil {
+		updates["bio"] = strings.TrimSpace(*input.Bio)
+	}
+	if input.Website != nil {
+		website := strings.TrimSpace(*input.Website)
+		if website != "" {
+			parsed, err := url.Parse(website)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				utils.CreateResponse(c, http.StatusBadRequest, "Website must be an http or https URL", nil)
+				return
+			}
+		}
+		updates["website"] = website
+	}
+	if input.Username != nil && *input.Username != currentUser.Username {
+		if err := validateUsername(*input.Username); err != nil {
+			utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		updates["username"] = *input.Username
+	}
+
+	if len(updates) > 0 {
+		err := database.DB.Model(&currentUser).Updates(updates).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			utils.CreateResponse(c, http.StatusConflict, "Username is already taken", nil)
+			return
+		}
+		if err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not update profile", nil)
+			return
+		}
+		// Beslemelerde yazar kullanıcı adıyla gösterilir
+		if _, renamed := updates["username"]; renamed {
+			events.PublishContentChanged()
+		}
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Profile updated successfully", buildProfileResponse(currentUser))
+}
+
+// ChangePassword godoc
+// @Summary Şifre değiştir
+// @Description Mevcut şifre doğrulandıktan sonra yeni şifreyi kaydeder ve mevcut oturum dışındaki tüm oturumları kapatır
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body requests.ChangePasswordRequest true "Mevcut ve yeni şifre"
+// @Success 200 {object} responses.MessageResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz veya mevcut şifre hatalı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/password [post]
+func ChangePassword(c *gin.Context) {
+	user, exists := c.Get("user")
+	sessionID, hasSession := c.Get("session_id")
+	if !exists || !hasSession {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var input requests.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(currentUser.Password), []byte(input.CurrentPassword)); err != nil {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Current password is incorrect", nil)
+		return
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not hash password", nil)
+		return
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&currentUser).Update("password", string(hashedPassword)).Error; err != nil {
+			return err
+		}
+		if err := invalidateUserTokens(tx, currentUser.ID, models.TokenPurposePasswordReset); err != nil {
+			return err
+		}
+		return revokeOtherSessions(tx, currentUser.ID, sessionID.(uuid.UUID))
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not change password", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Password changed successfully", nil)
+}
+
+// UploadAvatar godoc
+// @Summary Profil fotoğrafı yükle
+// @Description PNG, JPEG, GIF veya WebP biçiminde en fazla 2 MB'lık bir profil fotoğrafı yükler; önceki fotoğraf silinir
+// @Tags User
+// @Accept multipart/form-data
+// @Produce json
+// @Param avatar formData file true "Profil fotoğrafı"
+// @Success 200 {object} responses.ProfileResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz dosya"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 413 {object} responses.ErrorResponse "Dosya çok büyük"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/avatar [post]
+func UploadAvatar(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, avatarMaxBytes+1<<10)
+	fileHeader, err := c.FormFile("avatar")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			utils.CreateResponse(c, http.StatusRequestEntityTooLarge, "Avatar must be at most 2 MB", nil)
+			return
+		}
+		utils.CreateResponse(c, http.StatusBadRequest, "Avatar file is required", nil)
+		return
+	}
+	if fileHeader.Size > avatarMaxBytes {
+		utils.CreateResponse(c, http.StatusRequestEntityTooLarge, "Avatar must be at most 2 MB", nil)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, "Could not read avatar", nil)
+		return
+	}
+	defer file.Close()
+
+	// İstemcinin bildirdiği tür yerine dosya içeriğine bakılır
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(file, head)
+	extension, ok := avatarExtensions[http.DetectContentType(head[:n])]
+	if !ok {
+		utils.CreateResponse(c, http.StatusBadRequest, "Avatar must be a PNG, JPEG, GIF or WebP image", nil)
+		return
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not save avatar", nil)
+		return
+	}
+
+	suffix, err := utils.GenerateRandomToken(8)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not save avatar", nil)
+		return
+	}
+	fileName := currentUser.ID.String() + "-" + suffix + extension
+	avatarDir := filepath.Join(utils.UploadDir(), "avatars")
+
+	if err := saveUpload(file, avatarDir, fileName); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not save avatar", nil)
+		return
+	}
+
+	previous := currentUser.AvatarURL
+	if err := database.DB.Model(&currentUser).Update("avatar_url", "/uploads/avatars/"+fileName).Error; err != nil {
+		os.Remove(filepath.Join(avatarDir, fileName))
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not save avatar", nil)
+		return
+	}
+	if strings.HasPrefix(previous, "/uploads/avatars/") {
+		os.Remove(filepath.Join(avatarDir, filepath.Base(previous)))
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Avatar updated successfully", buildProfileResponse(currentUser))
+}
+
+// GetPublicProfile godoc
+// @Summary Yazar profilini getir
+// @Description Kullanıcı adına göre herkese açık profil bilgilerini, yazı ve yorum sayılarını ve son yazılarını döner
+// @Tags User
+// @Produce json
+// @Param username path string true "Kullanıcı adı"
+// @Success 200 {object} responses.PublicProfileResponse
+// @Failure 404 {object} responses.ErrorResponse "Kullanıcı bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/{username} [get]
+func GetPublicProfile(c *gin.Context) {
+	var user models.User
+	if err := database.DB.Where("username = ?", c.Param("username")).First(&user).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "User not found", nil)
+		return
+	}
+
+	profile := responses.PublicProfileResponse{
+		ID:        user.ID,
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Bio:       user.Bio,
+		Website:   user.Website,
+		AvatarURL: user.AvatarURL,
+		Posts:     []responses.PostResponse{},
+	}
+
+	var posts []models.Post
+	err := database.DB.Model(&models.Post{}).Where("author_id = ? AND status = ?", user.ID, models.PostStatusPublished).Count(&profile.PostCount).Error
+	if err == nil {
+		err = database.DB.Model(&models.Comment{}).Where("author_id = ? AND removed_at IS NULL AND status = ?", user.ID, models.CommentStatusApproved).Count(&profile.CommentCount).Error
+	}
+	if err == nil {
+		err = database.DB.Preload("Categories").Preload("Tags").Where("author_id = ? AND status = ?", user.ID, models.PostStatusPublished).Order("created_at DESC").Limit(profilePostLimit).Find(&posts).Error
+	}
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve profile", nil)
+		return
+	}
+
+	for _, post := range posts {
+		profile.Posts = append(profile.Posts, buildPostResponse(post))
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Profile retrieved successfully", profile)
+}
+
+func buildProfileResponse(user models.User) responses.ProfileResponse {
+	roles := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	return responses.ProfileResponse{
+		ID:            user.ID,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Username:      user.Username,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		TOTPEnabled:   user.TOTPEnabled,
+		Bio:           user.Bio,
+		Website:       user.Website,
+		AvatarURL:     user.AvatarURL,
+		Roles:         roles,
+
+		DeletionScheduledAt: user.DeletionScheduledAt,
+		DeletionMode:        user.DeletionMode,
+	}
+}
+
+// saveUpload dosyayı önce geçici bir ada yazar, tamamlanınca yerine taşır.
+func saveUpload(src io.Reader, dir, name string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, src); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, name))
+}
@@ -22,6 +22,7 @@ import (
 // @Success 200 {object} responses.ReactionResponse
 // @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
 // @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 404 {object} responses.ErrorResponse "Post bulunamadı veya yayımlanmamış"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /reactions [post]
 func AddReaction(c *gin.Context) {
@@ -43,6 +44,13 @@ func AddReaction(c *gin.Context) {
 		return
 	}
 
+	if input.PostID != nil {
+		if _, err := findPublishedPost(*input.PostID); err != nil {
+			utils.CreateResponse(c, http.StatusNotFound, "Post not found", nil)
+			return
+		}
+	}
+
 	reaction := models.Reaction{
 		Type:      models.ReactionType(input.Type),
 		UserID:    user.(models.User).ID,
@@ -55,14 +63,7 @@ func AddReaction(c *gin.Context) {
 		return
 	}
 
-	response := responses.ReactionResponse{
-		ID:        reaction.ID,
-		Type:      string(reaction.Type),
-		UserID:    reaction.UserID,
-		PostID:    reaction.PostID,
-		CommentID: reaction.CommentID,
-		CreatedAt: reaction.CreatedAt,
-	}
+	response := buildReactionResponse(reaction)
 	utils.CreateResponse(c, http.StatusOK, "Reaction added successfully", response)
 }
 
@@ -72,6 +73,10 @@ func AddReaction(c *gin.Context) {
 // @Tags Reaction
 // @Produce json
 // @Param post_id path int true "Post ID"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param sort query string false "Sıralama (varsayılan newest)" Enums(newest, oldest)
 // @Success 200 {object} responses.ReactionsResponse
 // @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
@@ -83,24 +88,29 @@ func GetReactionsByPost(c *gin.Context) {
 		return
 	}
 
-	var reactions []models.Reaction
-	if err := database.DB.Where("post_id = ?", postID).Find(&reactions).Error; err != nil {
-		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve reactions", nil)
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	sortKey, err := timeSortKey(c, "reactions", "newest")
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	query := database.DB.Model(&models.Reaction{}).Where("post_id = ?", postID)
+	reactions, meta, err := utils.Paginate(c, pagination, query, sortKey, "reactions.id", reactionCursor)
+	if err != nil {
+		respondListError(c, err, "Could not retrieve reactions")
 		return
 	}
 
-	var responseReactions []responses.ReactionResponse
+	responseReactions := make([]responses.ReactionResponse, 0, len(reactions))
 	for _, reaction := range reactions {
-		responseReactions = append(responseReactions, responses.ReactionResponse{
-			ID:        reaction.ID,
-			Type:      string(reaction.Type),
-			UserID:    reaction.UserID,
-			PostID:    reaction.PostID,
-			CommentID: reaction.CommentID,
-			CreatedAt: reaction.CreatedAt,
-		})
-	}
-	utils.CreateResponse(c, http.StatusOK, "Reactions retrieved successfully", responses.ReactionsResponse{Reactions: responseReactions})
+		responseReactions = append(responseReactions, buildReactionResponse(reaction))
+	}
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Reactions retrieved successfully", responses.ReactionsResponse{Reactions: responseReactions}, meta)
 }
 
 // GetReactionsByComment godoc
@@ -109,6 +119,10 @@ func GetReactionsByPost(c *gin.Context) {
 // @Tags Reaction
 // @Produce json
 // @Param comment_id path int true "Comment ID"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Param sort query string false "Sıralama (varsayılan newest)" Enums(newest, oldest)
 // @Success 200 {object} responses.ReactionsResponse
 // @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
@@ -120,24 +134,29 @@ func GetReactionsByComment(c *gin.Context) {
 		return
 	}
 
-	var reactions []models.Reaction
-	if err := database.DB.Where("comment_id = ?", commentID).Find(&reactions).Error; err != nil {
-		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve reactions", nil)
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	sortKey, err := timeSortKey(c, "reactions", "newest")
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	query := database.DB.Model(&models.Reaction{}).Where("comment_id = ?", commentID)
+	reactions, meta, err := utils.Paginate(c, pagination, query, sortKey, "reactions.id", reactionCursor)
+	if err != nil {
+		respondListError(c, err, "Could not retrieve reactions")
 		return
 	}
 
-	var responseReactions []responses.ReactionResponse
+	responseReactions := make([]responses.ReactionResponse, 0, len(reactions))
 	for _, reaction := range reactions {
-		responseReactions = append(responseReactions, responses.ReactionResponse{
-			ID:        reaction.ID,
-			Type:      string(reaction.Type),
-			UserID:    reaction.UserID,
-			PostID:    reaction.PostID,
-			CommentID: reaction.CommentID,
-			CreatedAt: reaction.CreatedAt,
-		})
-	}
-	utils.CreateResponse(c, http.StatusOK, "Reactions retrieved successfully", responses.ReactionsResponse{Reactions: responseReactions})
+		responseReactions = append(responseReactions, buildReactionResponse(reaction))
+	}
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Reactions retrieved successfully", responses.ReactionsResponse{Reactions: responseReactions}, meta)
 }
 
 // RemoveReaction godoc
@@ -183,3 +202,18 @@ func RemoveReaction(c *gin.Context) {
 
 	utils.CreateResponse(c, http.StatusOK, "Reaction deleted successfully", nil)
 }
+
+func buildReactionResponse(reaction models.Reaction) responses.ReactionResponse {
+	return responses.ReactionResponse{
+		ID:        reaction.ID,
+		Type:      string(reaction.Type),
+		UserID:    reaction.UserID,
+		PostID:    reaction.PostID,
+		CommentID: reaction.CommentID,
+		CreatedAt: reaction.CreatedAt,
+	}
+}
+
+func reactionCursor(reaction models.Reaction) (interface{}, uint) {
+	return reaction.CreatedAt, reaction.ID
+}
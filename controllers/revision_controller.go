@@ -0,0 +1,614 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/diff"
+	"blog-platform/events"
+	"blog-platform/models"
+	"blog-platform/responses"
+	"blog-platform/search"
+	"blog-platform/utils"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetPostRevisions godoc
+// @Summary Postun sürümlerini listele
+// @Description Postun kaydedilmiş tüm sürümlerini yeniden eskiye sayfalı olarak listeler. Yalnızca yazar, Editor ve Admin görebilir.
+// @Tags Post
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Success 200 {object} responses.PostRevisionsResponse
+// @Failure 403 {object} responses.ErrorResponse "Yetkisiz erişim"
+// @Failure 404 {object} responses.ErrorResponse "Post bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /posts/{post_id}/revisions [get]
+func GetPostRevisions(c *gin.Context) {
+	post, ok := loadPostHistory(c)
+	if !ok {
+		return
+	}
+
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	sortKey := utils.SortKey{Expr: "post_revisions.number", Desc: true, IsNumber: true}
+	query := database.DB.Model(&models.PostRevision{}).Where("post_id = ?", post.ID)
+	revisions, meta, err := utils.Paginate(c, pagination, query, sortKey, "post_revisions.id",
+		func(revision models.PostRevision) (interface{}, uint) { return revision.Number, revision.ID })
+	if err != nil {
+		respondListError(c, err, "Could not retrieve revisions")
+		return
+	}
+
+	responseRevisions := make([]responses.PostRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		responseRevisions = append(responseRevisions, buildPostRevisionResponse(revision))
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Revisions retrieved successfully", responses.PostRevisionsResponse{Revisions: responseRevisions}, meta)
+}
+
+// GetPostRevision godoc
+// @Summary Postun belirli bir sürümünü getir
+// @Description Postun numarası verilen sürümünü getirir. Yalnızca yazar, Editor ve Admin görebilir.
+// @Tags Post
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param revision path int true "Sürüm numarası"
+// @Success 200 {object} responses.PostRevisionResponse
+// @Failure 403 {object} responses.ErrorResponse "Yetkisiz erişim"
+// @Failure 404 {object} responses.ErrorResponse "Post veya sürüm bulunamadı"
+// @Router /posts/{post_id}/revisions/{revision} [get]
+func GetPostRevision(c *gin.Context) {
+	post, ok := loadPostHistory(c)
+	if !ok {
+		return
+	}
+
+	var revision models.PostRevision
+	if err := database.DB.Where("post_id = ? AND number = ?", post.ID, c.Param("revision")).First(&revision).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Revision not found", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Revision retrieved successfully", buildPostRevisionResponse(revision))
+}
+
+// GetPostRevisionDiff godoc
+// @Summary İki post sürümü arasındaki farkı getir
+// @Description Başlık ve içerik farkını satır veya kelime düzeyinde döner. to verilmezse en son sürüm, from verilmezse ondan bir önceki sürüm kullanılır.
+// @Tags Post
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param from query int false "Eski sürüm numarası"
+// @Param to query int false "Yeni sürüm numarası"
+// @Param mode query string false "Fark düzeyi (varsayılan line)" Enums(line, word)
+// @Success 200 {object} responses.RevisionDiffResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
+// @Failure 403 {object} responses.ErrorResponse "Yetkisiz erişim"
+// @Failure 404 {object} responses.ErrorResponse "Post veya sürüm bulunamadı"
+// @Router /posts/{post_id}/revisions/diff [get]
+func GetPostRevisionDiff(c *gin.Context) {
+	post, ok := loadPostHistory(c)
+	if !ok {
+		return
+	}
+
+	latest, err := latestRevisionNumber(database.DB, &models.PostRevision{}, "post_id", post.ID)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not compute diff", nil)
+		return
+	}
+	from, to, mode, err := parseDiffRange(c, latest, "line")
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var revisions []models.PostRevision
+	if err := database.DB.Where("post_id = ? AND number IN ?", post.ID, []int{from, to}).Find(&revisions).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not compute diff", nil)
+		return
+	}
+	byNumber := map[int]models.PostRevision{}
+	for _, revision := range revisions {
+		byNumber[revision.Number] = revision
+	}
+	old, okOld := byNumber[from]
+	current, okCurrent := byNumber[to]
+	if !okOld || !okCurrent {
+		utils.CreateResponse(c, http.StatusNotFound, "Revision not found", nil)
+		return
+	}
+
+	response := responses.RevisionDiffResponse{
+		From:    from,
+		To:      to,
+		Mode:    mode,
+		Title:   diffText(mode, old.Title, current.Title),
+		Content: diffText(mode, old.Content, current.Content),
+	}
+	utils.CreateResponse(c, http.StatusOK, "Diff computed successfully", response)
+}
+
+// RestorePostRevision godoc
+// @Summary Postu eski bir sürüme geri döndür
+// @Description Postun başlık ve içeriğini verilen sürümdeki haline getirir ve bunu yeni bir sürüm olarak kaydeder; geçmiş silinmez. Yazar, Editor ve Admin yapabilir.
+// @Tags Post
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param revision path int true "Geri dönülecek sürüm numarası"
+// @Success 200 {object} responses.PostResponse
+// @Failure 403 {object} responses.ErrorResponse "Yetkisiz erişim"
+// @Failure 404 {object} responses.ErrorResponse "Post veya sürüm bulunamadı"
+// @Failure 409 {object} responses.ErrorResponse "Post zaten bu sürümle aynı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /posts/{post_id}/revisions/{revision}/restore [post]
+func RestorePostRevision(c *gin.Context) {
+	post, ok := loadPostHistory(c)
+	if !ok {
+		return
+	}
+	currentUser := c.MustGet("user").(models.User)
+
+	var revision models.PostRevision
+	if err := database.DB.Where("post_id = ? AND number = ?", post.ID, c.Param("revision")).First(&revision).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Revision not found", nil)
+		return
+	}
+	if post.Title == revision.Title && post.Content == revision.Content && post.ContentFormat == revision.Format {
+		utils.CreateResponse(c, http.StatusConflict, "Post already matches this revision", nil)
+		return
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := ensurePostBaseRevision(tx, post); err != nil {
+			return err
+		}
+		post.Title = revision.Title
+		post.Content = revision.Content
+		post.ContentFormat = revision.Format
+		if err := renderPostContent(&post); err != nil {
+			return err
+		}
+		if err := tx.Model(&post).Updates(map[string]interface{}{
+			"title":          post.Title,
+			"content":        post.Content,
+			"content_format": post.ContentFormat,
+			"content_html":   post.ContentHTML,
+			"version":        bumpVersion,
+		}).Error; err != nil {
+			return err
+		}
+		post.Version++
+		if err := search.IndexPost(tx, post); err != nil {
+			return err
+		}
+		return recordPostRevision(tx, post, currentUser, fmt.Sprintf("Restored revision %d", revision.Number))
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not restore revision", nil)
+		return
+	}
+
+	if err := reloadPostTaxonomies(&post); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not restore revision", nil)
+		return
+	}
+
+	if post.Status == models.PostStatusPublished {
+		events.PublishContentChanged()
+	}
+
+	utils.SetVersionETag(c, post.Version)
+	utils.CreateResponse(c, http.StatusOK, "Revision restored successfully", buildPostResponse(post))
+}
+
+// GetCommentRevisions godoc
+// @Summary Yorumun sürümlerini listele
+// @Description Yorumun kaydedilmiş tüm sürümlerini yeniden eskiye sayfalı olarak listeler. Yalnızca yorumun yazarı, Editor ve Admin görebilir.
+// @Tags Comment
+// @Produce json
+// @Param comment_id path int true "Comment ID"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Param cursor query string false "Önceki yanıttaki meta.next_cursor"
+// @Success 200 {object} responses.CommentRevisionsResponse
+// @Failure 403 {object} responses.ErrorResponse "Erişim reddedildi"
+// @Failure 404 {object} responses.ErrorResponse "Yorum bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /comments/{comment_id}/revisions [get]
+func GetCommentRevisions(c *gin.Context) {
+	comment, ok := loadCommentHistory(c)
+	if !ok {
+		return
+	}
+
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	sortKey := utils.SortKey{Expr: "comment_revisions.number", Desc: true, IsNumber: true}
+	query := database.DB.Model(&models.CommentRevision{}).Where("comment_id = ?", comment.ID)
+	revisions, meta, err := utils.Paginate(c, pagination, query, sortKey, "comment_revisions.id",
+		func(revision models.CommentRevision) (interface{}, uint) { return revision.Number, revision.ID })
+	if err != nil {
+		respondListError(c, err, "Could not retrieve revisions")
+		return
+	}
+
+	responseRevisions := make([]responses.CommentRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		responseRevisions = append(responseRevisions, buildCommentRevisionResponse(revision))
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Revisions retrieved successfully", responses.CommentRevisionsResponse{Revisions: responseRevisions}, meta)
+}
+
+// GetCommentRevisionDiff godoc
+// @Summary İki yorum sürümü arasındaki farkı getir
+// @Description İçerik farkını satır veya kelime düzeyinde döner. to verilmezse en son sürüm, from verilmezse ondan bir önceki sürüm kullanılır.
+// @Tags Comment
+// @Produce json
+// @Param comment_id path int true "Comment ID"
+// @Param from query int false "Eski sürüm numarası"
+// @Param to query int false "Yeni sürüm numarası"
+// @Param mode query string false "Fark düzeyi (varsayılan word)" Enums(line, word)
+// @Success 200 {object} responses.RevisionDiffResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
+// @Failure 403 {object} responses.ErrorResponse "Erişim reddedildi"
+// @Failure 404 {object} responses.ErrorResponse "Yorum veya sürüm bulunamadı"
+// @Router /comments/{comment_id}/revisions/diff [get]
+func GetCommentRevisionDiff(c *gin.Context) {
+	comment, ok := loadCommentHistory(c)
+	if !ok {
+		return
+	}
+
+	latest, err := latestRevisionNumber(database.DB, &models.CommentRevision{}, "comment_id", comment.ID)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not compute diff", nil)
+		return
+	}
+	// Yorumlar genellikle tek paragraf olduğundan varsayılan kelime düzeyidir
+	from, to, mode, err := parseDiffRange(c, latest, "word")
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var revisions []models.CommentRevision
+	if err := database.DB.Where("comment_id = ? AND number IN ?", comment.ID, []int{from, to}).Find(&revisions).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not compute diff", nil)
+		return
+	}
+	byNumber := map[int]models.CommentRevision{}
+	for _, revision := range revisions {
+		byNumber[revision.Number] = revision
+	}
+	old, okOld := byNumber[from]
+	current, okCurrent := byNumber[to]
+	if !okOld || !okCurrent {
+		utils.CreateResponse(c, http.StatusNotFound, "Revision not found", nil)
+		return
+	}
+
+	response := responses.RevisionDiffResponse{
+		From:    from,
+		To:      to,
+		Mode:    mode,
+		Content: diffText(mode, old.Content, current.Content),
+	}
+	utils.CreateResponse(c, http.StatusOK, "Diff computed successfully", response)
+}
+
+// RestoreCommentRevision godoc
+// @Summary Yorumu eski bir sürüme geri döndür
+// @Description Yorumun içeriğini verilen sürümdeki haline getirir ve bunu yeni bir sürüm olarak kaydeder. Yorumun yazarı, Editor ve Admin yapabilir. Moderatör olmayanların geri yüklemeleri düzenlemeler gibi spam kontrolünden geçer; şüpheli içerik onaylanmış yorumu yeniden onaya gönderir (202).
+// @Tags Comment
+// @Produce json
+// @Param comment_id path int true "Comment ID"
+// @Param revision path int true "Geri dönülecek sürüm numarası"
+// @Success 200 {object} responses.CommentResponse
+// @Success 202 {object} responses.CommentResponse "Geri yüklenen içerik spam şüphesiyle yeniden onaya gönderildi"
+// @Failure 403 {object} responses.ErrorResponse "Erişim reddedildi"
+// @Failure 404 {object} responses.ErrorResponse "Yorum veya sürüm bulunamadı"
+// @Failure 409 {object} responses.ErrorResponse "Yorum zaten bu sürümle aynı"
+// @Failure 422 {object} responses.ErrorResponse "Geri yüklenen içerik spam olarak reddedildi"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /comments/{comment_id}/revisions/{revision}/restore [post]
+func RestoreCommentRevision(c *gin.Context) {
+	comment, ok := loadCommentHistory(c)
+	if !ok {
+		return
+	}
+	currentUser := c.MustGet("user").(models.User)
+
+	var revision models.CommentRevision
+	if err := database.DB.Where("comment_id = ? AND number = ?", comment.ID, c.Param("revision")).First(&revision).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Revision not found", nil)
+		return
+	}
+	if comment.Content == revision.Content && comment.ContentFormat == revision.Format {
+		utils.CreateResponse(c, http.StatusConflict, "Comment already matches this revision", nil)
+		return
+	}
+
+	// Eski sürüm de spam içerebilir; geri yükleme düzenleme gibi kontrol edilir
+	holdReason, ok := checkCommentEdit(c, comment, currentUser, revision.Content, "Could not restore revision")
+	if !ok {
+		return
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := ensureCommentBaseRevision(tx, comment); err != nil {
+			return err
+		}
+		from := comment.Status
+		comment.Content = revision.Content
+		comment.ContentFormat = revision.Format
+		if err := renderCommentContent(&comment); err != nil {
+			return err
+		}
+		updates := map[string]interface{}{
+			"content":        comment.Content,
+			"content_format": comment.ContentFormat,
+			"content_html":   comment.ContentHTML,
+			"version":        bumpVersion,
+		}
+		if holdReason != "" {
+			updates["status"] = models.CommentStatusPending
+			comment.Status = models.CommentStatusPending
+		}
+		if err := tx.Model(&comment).Updates(updates).Error; err != nil {
+			return err
+		}
+		comment.Version++
+		if err := search.IndexComment(tx, comment); err != nil {
+			return err
+		}
+		if holdReason != "" {
+			if err := search.IndexReplies(tx, comment); err != nil {
+				return err
+			}
+			if err := recordCommentModeration(tx, comment.ID, from, comment.Status, models.SystemActorID, holdReason); err != nil {
+				return err
+			}
+		}
+		return recordCommentRevision(tx, comment, currentUser, fmt.Sprintf("Restored revision %d", revision.Number))
+	})
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not restore revision", nil)
+		return
+	}
+
+	utils.SetVersionETag(c, comment.Version)
+	if holdReason != "" {
+		utils.CreateResponse(c, http.StatusAccepted, "Comment is awaiting moderation", buildCommentResponse(comment))
+		return
+	}
+	utils.CreateResponse(c, http.StatusOK, "Revision restored successfully", buildCommentResponse(comment))
+}
+
+// loadPostHistory postu yükler ve geçmişini yalnızca yazara, Editor ve
+// Admin'e açar. Başarısız olursa yanıtı yazar ve false döner.
+func loadPostHistory(c *gin.Context) (models.Post, bool) {
+	var post models.Post
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return post, false
+	}
+	currentUser := user.(models.User)
+
+	if err := database.DB.Where("id = ?", c.Param("post_id")).First(&post).Error; err != nil || !canViewPost(post, &currentUser) {
+		utils.CreateResponse(c, http.StatusNotFound, "Post not found", nil)
+		return post, false
+	}
+	if post.AuthorID != currentUser.ID && !isEditor(currentUser) {
+		utils.CreateResponse(c, http.StatusForbidden, "You are not allowed to view this post's history", nil)
+		return post, false
+	}
+	return post, true
+}
+
+// loadCommentHistory yorumu yükler ve geçmişini yalnızca yorumun yazarına,
+// Editor ve Admin'e açar.
+func loadCommentHistory(c *gin.Context) (models.Comment, bool) {
+	var comment models.Comment
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return comment, false
+	}
+	currentUser := user.(models.User)
+
+	if err := database.DB.Preload("Post").Where("id = ? AND removed_at IS NULL", c.Param("comment_id")).First(&comment).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Comment not found", nil)
+		return comment, false
+	}
+	if comment.AuthorID != currentUser.ID && !isEditor(currentUser) {
+		utils.CreateResponse(c, http.StatusForbidden, "You are not allowed to view this comment's history", nil)
+		return comment, false
+	}
+	return comment, true
+}
+
+// parseDiffRange from, to ve mode parametrelerini okur; varsayılan olarak son
+// iki sürüm karşılaştırılır.
+func parseDiffRange(c *gin.Context, latest int, defaultMode string) (int, int, string, error) {
+	mode := c.DefaultQuery("mode", defaultMode)
+	if mode != "line" && mode != "word" {
+		return 0, 0, "", errors.New("mode must be line or word")
+	}
+
+	to := latest
+	if value := c.Query("to"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return 0, 0, "", errors.New("to must be a positive revision number")
+		}
+		to = parsed
+	}
+	from := to - 1
+	if value := c.Query("from"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return 0, 0, "", errors.New("from must be a positive revision number")
+		}
+		from = parsed
+	}
+	if from < 1 {
+		return 0, 0, "", errors.New("there is no earlier revision to compare with")
+	}
+	return from, to, mode, nil
+}
+
+func diffText(mode, old, current string) []responses.DiffOp {
+	var ops []diff.Op
+	if mode == "word" {
+		ops = diff.Words(old, current)
+	} else {
+		ops = diff.Lines(old, current)
+	}
+
+	result := make([]responses.DiffOp, 0, len(ops))
+	for _, op := range ops {
+		result = append(result, responses.DiffOp{Type: op.Type, Text: op.Text})
+	}
+	return result
+}
+
+// latestRevisionNumber kaydın en son sürüm numarasını, hiç sürümü yoksa 0 döner.
+func latestRevisionNumber(tx *gorm.DB, model interface{}, column string, id uint) (int, error) {
+	var latest int
+	err := tx.Model(model).Where(column+" = ?", id).Select("COALESCE(MAX(number), 0)").Scan(&latest).Error
+	return latest, err
+}
+
+// lockRevisionParent sürümü yazılacak post veya yorum satırını işlem sonuna
+// kadar kilitler. Böylece eşzamanlı iki düzenleme aynı sürüm numarasını alıp
+// benzersiz index'e takılmaz; ikincisi birincinin bitmesini bekler. SQLite
+// kilitleme ifadesini yok sayar ancak yazmaları zaten sıraya koyar.
+func lockRevisionParent(tx *gorm.DB, parent interface{}, id uint) error {
+	var locked []uint
+	return tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Model(parent).Where("id = ?", id).Pluck("id", &locked).Error
+}
+
+// recordPostRevision postun mevcut başlık ve içeriğini yeni bir sürüm olarak kaydeder.
+func recordPostRevision(tx *gorm.DB, post models.Post, editor models.User, note string) error {
+	if err := lockRevisionParent(tx, &models.Post{}, post.ID); err != nil {
+		return err
+	}
+	latest, err := latestRevisionNumber(tx, &models.PostRevision{}, "post_id", post.ID)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&models.PostRevision{
+		PostID:     post.ID,
+		Number:     latest + 1,
+		Title:      post.Title,
+		Content:    post.Content,
+		Format:     post.ContentFormat,
+		EditorID:   editor.ID,
+		ChangeNote: note,
+	}).Error
+}
+
+// ensurePostBaseRevision sürüm geçmişi tutulmaya başlanmadan önce oluşturulmuş
+// postların değiştirilmeden önceki halini ilk sürüm olarak kaydeder.
+func ensurePostBaseRevision(tx *gorm.DB, post models.Post) error {
+	if err := lockRevisionParent(tx, &models.Post{}, post.ID); err != nil {
+		return err
+	}
+	latest, err := latestRevisionNumber(tx, &models.PostRevision{}, "post_id", post.ID)
+	if err != nil || latest > 0 {
+		return err
+	}
+	return tx.Create(&models.PostRevision{
+		PostID:    post.ID,
+		Number:    1,
+		Title:     post.Title,
+		Content:   post.Content,
+		Format:    post.ContentFormat,
+		EditorID:  post.AuthorID,
+		CreatedAt: post.UpdatedAt,
+	}).Error
+}
+
+// recordCommentRevision yorumun mevcut içeriğini yeni bir sürüm olarak kaydeder.
+func recordCommentRevision(tx *gorm.DB, comment models.Comment, editor models.User, note string) error {
+	if err := lockRevisionParent(tx, &models.Comment{}, comment.ID); err != nil {
+		return err
+	}
+	latest, err := latestRevisionNumber(tx, &models.CommentRevision{}, "comment_id", comment.ID)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&models.CommentRevision{
+		CommentID:  comment.ID,
+		Number:     latest + 1,
+		Content:    comment.Content,
+		Format:     comment.ContentFormat,
+		EditorID:   editor.ID,
+		ChangeNote: note,
+	}).Error
+}
+
+// ensureCommentBaseRevision sürüm geçmişi olmayan yorumun değiştirilmeden
+// önceki halini ilk sürüm olarak kaydeder.
+func ensureCommentBaseRevision(tx *gorm.DB, comment models.Comment) error {
+	if err := lockRevisionParent(tx, &models.Comment{}, comment.ID); err != nil {
+		return err
+	}
+	latest, err := latestRevisionNumber(tx, &models.CommentRevision{}, "comment_id", comment.ID)
+	if err != nil || latest > 0 {
+		return err
+	}
+	return tx.Create(&models.CommentRevision{
+		CommentID: comment.ID,
+		Number:    1,
+		Content:   comment.Content,
+		Format:    comment.ContentFormat,
+		EditorID:  comment.AuthorID,
+		CreatedAt: comment.UpdatedAt,
+	}).Error
+}
+
+func buildPostRevisionResponse(revision models.PostRevision) responses.PostRevisionResponse {
+	return responses.PostRevisionResponse{
+		Number:        revision.Number,
+		Title:         revision.Title,
+		Content:       revision.Content,
+		ContentFormat: revision.Format,
+		EditorID:      revision.EditorID,
+		ChangeNote:    revision.ChangeNote,
+		CreatedAt:     revision.CreatedAt,
+	}
+}
+
+func buildCommentRevisionResponse(revision models.CommentRevision) responses.CommentRevisionResponse {
+	return responses.CommentRevisionResponse{
+		Number:        revision.Number,
+		Content:       revision.Content,
+		ContentFormat: revision.Format,
+		EditorID:      revision.EditorID,
+		ChangeNote:    revision.ChangeNote,
+		CreatedAt:     revision.CreatedAt,
+	}
+}
@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/responses"
+	"blog-platform/search"
+	"blog-platform/utils"
+	"errors"
+	"net/http"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// maxSearchQueryLength q parametresinin karakter cinsinden en fazla uzunluğudur.
+const maxSearchQueryLength = 200
+
+// Search godoc
+// @Summary Postlarda ve yorumlarda ara
+// @Description Yayımlanmış postların başlık ve içeriğinde, bunlara yazılmış yorumlarda tam metin arama yapar. Sonuçlar alaka puanına göre sıralanır; başlıkta geçen kelimeler daha yüksek puan alır. Her kelime önek olarak eşleşir ve tüm kelimeler bulunmalıdır; büyük/küçük harf ve Türkçe karakterler (ı, ş, ğ…) ayırt edilmez. snippet alanı eşleşmeleri <mark> ile işaretlenmiş HTML'dir. Cursor ile sayfalama desteklenmez.
+// @Tags Search
+// @Produce json
+// @Param q query string true "Aranacak kelimeler (en fazla 200 karakter)"
+// @Param type query string false "Sonuç türü (varsayılan all)" Enums(all, post, comment)
+// @Param author query string false "Yazarın kullanıcı adı"
+// @Param author_id query string false "Yazarın ID'si"
+// @Param category_id query int false "Postun kategori ID'si"
+// @Param from query string false "Bu tarihten sonra oluşturulanlar (RFC3339 veya YYYY-MM-DD)"
+// @Param to query string false "Bu tarihten önce oluşturulanlar (RFC3339 veya YYYY-MM-DD, gün dahil)"
+// @Param page query int false "Sayfa numarası (varsayılan 1)"
+// @Param limit query int false "Sayfa başına kayıt (varsayılan 20, en fazla 100)"
+// @Success 200 {object} responses.SearchResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz parametre"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /search [get]
+func Search(c *gin.Context) {
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if pagination.UsesCursor() {
+		utils.CreateResponse(c, http.StatusBadRequest, "Search results are paginated with page, not cursor", nil)
+		return
+	}
+
+	text := c.Query("q")
+	if text == "" {
+		utils.CreateResponse(c, http.StatusBadRequest, "q is required", nil)
+		return
+	}
+	if utf8.RuneCountInString(text) > maxSearchQueryLength {
+		utils.CreateResponse(c, http.StatusBadRequest, "q must be at most 200 characters", nil)
+		return
+	}
+
+	query, err := searchQueryFromRequest(c, text)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Bilinmeyen yazarın sonucu olamaz
+		utils.CreatePaginatedResponse(c, http.StatusOK, "Search completed successfully", responses.SearchResponse{Results: []responses.SearchResultResponse{}}, pagination.OffsetMeta(c, 0))
+		return
+	}
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	query.Offset = pagination.Offset()
+	query.Limit = pagination.Limit
+
+	results, total, err := search.Search(database.DB, query)
+	if errors.Is(err, search.ErrEmptyQuery) {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not search", nil)
+		return
+	}
+
+	responseResults := make([]responses.SearchResultResponse, 0, len(results))
+	for _, result := range results {
+		responseResults = append(responseResults, responses.SearchResultResponse{
+			Type:      result.Kind,
+			ID:        result.ID,
+			PostID:    result.PostID,
+			PostSlug:  result.PostSlug,
+			PostTitle: result.PostTitle,
+			AuthorID:  result.AuthorID,
+			Snippet:   result.Snippet,
+			Rank:      result.Rank,
+			CreatedAt: result.CreatedAt,
+		})
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Search completed successfully", responses.SearchResponse{Results: responseResults}, pagination.OffsetMeta(c, total))
+}
+
+// searchQueryFromRequest arama filtrelerini doğrular. author bilinen bir
+// kullanıcı değilse gorm.ErrRecordNotFound döner.
+func searchQueryFromRequest(c *gin.Context, text string) (search.Query, error) {
+	query := search.Query{Text: text}
+
+	switch kind := c.DefaultQuery("type", "all"); kind {
+	case "all":
+	case search.KindPost, search.KindComment:
+		query.Kind = kind
+	default:
+		return query, errors.New("type must be one of all, post, comment")
+	}
+
+	if authorID := c.Query("author_id"); authorID != "" {
+		parsed, err := uuid.Parse(authorID)
+		if err != nil {
+			return query, errors.New("author_id must be a valid UUID")
+		}
+		query.AuthorID = &parsed
+	}
+	if author := c.Query("author"); author != "" {
+		var user models.User
+		if err := database.DB.Select("id").Where("username = ?", author).First(&user).Error; err != nil {
+			return query, err
+		}
+		if query.AuthorID != nil && *query.AuthorID != user.ID {
+			return query, gorm.ErrRecordNotFound
+		}
+		query.AuthorID = &user.ID
+	}
+
+	if categoryID := c.Query("category_id"); categoryID != "" {
+		id, err := strconv.ParseUint(categoryID, 10, 64)
+		if err != nil {
+			return query, errors.New("category_id must be a positive integer")
+		}
+		query.CategoryIDs = []uint{uint(id)}
+	}
+
+	if from := c.Query("from"); from != "" {
+		start, _, err := parseDateParam(from)
+		if err != nil {
+			return query, errors.New("from must be an RFC3339 timestamp or YYYY-MM-DD date")
+		}
+		query.From = &start
+	}
+	if to := c.Query("to"); to != "" {
+		end, dateOnly, err := parseDateParam(to)
+		if err != nil {
+			return query, errors.New("to must be an RFC3339 timestamp or YYYY-MM-DD date")
+		}
+		// Yalnızca tarih verilmişse o günün tamamı dahil edilir
+		if dateOnly {
+			end = end.AddDate(0, 0, 1)
+			query.Until = &end
+		} else {
+			query.To = &end
+		}
+	}
+	return query, nil
+}
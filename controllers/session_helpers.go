@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/responses"
+	"blog-platform/utils"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// completeLogin birinci faktörü doğrulanmış kullanıcının girişini tamamlar.
+// TOTP etkinse gerçek token'lar yerine /users/login/mfa adımında kullanılacak
+// kısa ömürlü bir mfa_token döner. Kullanıcının rolleri yüklenmiş olmalıdır.
+func completeLogin(c *gin.Context, user models.User) {
+	if user.TOTPEnabled {
+		mfaToken, err := utils.GenerateMFAToken(user.ID)
+		if err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not generate token", nil)
+			return
+		}
+
+		loginResponse := responses.LoginResponse{
+			Message:     "Two-factor authentication required",
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}
+		utils.CreateResponse(c, http.StatusOK, "Two-factor authentication required", loginResponse)
+		return
+	}
+
+	loginResponse, err := startSession(c, user)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not generate token", nil)
+		return
+	}
+
+	loginResponse.Message = "Login successful"
+	loginResponse.MFAEnrollmentRequired = utils.RequiresMFA(user)
+	utils.CreateResponse(c, http.StatusOK, "Login successful", loginResponse)
+}
+
+// startSession kullanıcı için yeni bir oturum (refresh token ailesi) açar ve
+// ilk access/refresh token çiftini döner.
+func startSession(c *gin.Context, user models.User) (responses.LoginResponse, error) {
+	now := time.Now()
+	session := models.Session{
+		ID:         uuid.New(),
+		UserID:     user.ID,
+		UserAgent:  c.Request.UserAgent(),
+		IPAddress:  c.ClientIP(),
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+
+	var tokens responses.LoginResponse
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&session).Error; err != nil {
+			return err
+		}
+
+		var err error
+		tokens, err = issueTokens(tx, session)
+		return err
+	})
+	return tokens, err
+}
+
+// issueTokens oturum için yeni bir refresh token kaydeder ve access token üretir.
+func issueTokens(tx *gorm.DB, session models.Session) (responses.LoginResponse, error) {
+	refreshToken, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return responses.LoginResponse{}, err
+	}
+
+	record := models.RefreshToken{
+		SessionID: session.ID,
+		TokenHash: utils.HashToken(refreshToken),
+		ExpiresAt: time.Now().Add(utils.RefreshTokenTTL()),
+	}
+	if err := tx.Create(&record).Error; err != nil {
+		return responses.LoginResponse{}, err
+	}
+
+	accessToken, err := utils.GenerateJWT(session.UserID, session.ID)
+	if err != nil {
+		return responses.LoginResponse{}, err
+	}
+
+	return responses.LoginResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(utils.AccessTokenTTL().Seconds()),
+	}, nil
+}
+
+// revokeSession tek bir oturumu ve ona bağlı refresh token ailesini iptal eder.
+func revokeSession(tx *gorm.DB, sessionID uuid.UUID) error {
+	return tx.Model(&models.Session{}).
+		Where("id = ? AND revoked_at IS NULL", sessionID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// revokeUserSessions kullanıcının tüm açık oturumlarını iptal eder.
+func revokeUserSessions(tx *gorm.DB, userID uuid.UUID) error {
+	return tx.Model(&models.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// revokeOtherSessions mevcut oturum dışındaki tüm açık oturumları iptal eder.
+func revokeOtherSessions(tx *gorm.DB, userID, currentSessionID uuid.UUID) error {
+	return tx.Model(&models.Session{}).
+		Where("user_id = ? AND id <> ? AND revoked_at IS NULL", userID, currentSessionID).
+		Update("revoked_at", time.Now()).Error
+}
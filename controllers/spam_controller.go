@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"blog-platform/responses"
+	"blog-platform/spam"
+	"blog-platform/utils"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetFormToken godoc
+// @Summary Form token'ı al
+// @Description Kayıt veya yorum formu açılırken çağrılır. Dönen token gönderimde form_token alanıyla geri yollanır; token gönderilmezse, geçersizse veya form SPAM_MIN_SUBMIT_TIME süresinden hızlı gönderilirse spam puanı artar. Token isteği yapan IP adresine bağlıdır, 24 saat geçerlidir ve ilk kullanımından sonra 10 dakika boyunca tekrar gönderilebilir.
+// @Tags Spam
+// @Produce json
+// @Success 200 {object} responses.FormTokenResponse
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /forms/token [get]
+func GetFormToken(c *gin.Context) {
+	now := time.Now()
+	token, err := spam.IssueFormToken(now, c.ClientIP())
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not issue form token", nil)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	utils.CreateResponse(c, http.StatusOK, "Form token issued successfully", responses.FormTokenResponse{
+		Token:     token,
+		ExpiresAt: now.Add(spam.FormTokenTTL).UTC(),
+	})
+}
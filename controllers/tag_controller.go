@@ -0,0 +1,333 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/events"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/responses"
+	"blog-platform/utils"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	errInvalidTag       = errors.New("Tags must contain at least one letter or digit")
+	errTagSlugTaken     = errors.New("Another tag already uses this slug; merge the tags instead")
+	errTagNotFound      = errors.New("Tag not found")
+	errMergeTagIntoSelf = errors.New("A tag cannot be merged into itself")
+	errMergeTagNotFound = errors.New("Target tag not found")
+)
+
+// tagPostCountExpr bir etiketi kullanan yayımlanmış, silinmemiş post sayısıdır.
+const tagPostCountExpr = `(SELECT COUNT(*) FROM post_tags JOIN posts ON posts.id = post_tags.post_id
+	WHERE post_tags.tag_id = tags.id AND posts.deleted_at IS NULL AND posts.status = 'published')`
+
+// tagSortKeys GET /tags için ?sort= parametresinin alabileceği değerlerdir.
+var tagSortKeys = map[string]utils.SortKey{
+	"popular": {Expr: tagPostCountExpr, Desc: true, IsNumber: true},
+	"name":    {Expr: "tags.slug"},
+}
+
+// tagListRow etiketi kullanım sayısıyla birlikte taşır.
+type tagListRow struct {
+	models.Tag
+	PostCount int64 `gorm:"column:post_count;->"`
+}
+
+// GetTags godoc
+// @Summary Retrieve tags
+// @Description Get a paginated list of tags used by at least one published post, with the number of such posts. Sorted by usage by default, which makes the list usable as a tag cloud.
+// @Tags Tags
+// @Produce json
+// @Param sort query string false "Sort order (default popular)" Enums(popular, name)
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default 20, max 100)"
+// @Param cursor query string false "meta.next_cursor from the previous response"
+// @Success 200 {object} []responses.TagUsageResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid parameters"
+// @Failure 500 {object} responses.ErrorResponse "Could not retrieve tags"
+// @Router /tags [get]
+func GetTags(c *gin.Context) {
+	pagination, err := utils.ParsePagination(c)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	sortKey, ok := tagSortKeys[c.DefaultQuery("sort", "popular")]
+	if !ok {
+		utils.CreateResponse(c, http.StatusBadRequest, "sort must be popular or name", nil)
+		return
+	}
+
+	query := database.DB.Model(&models.Tag{}).
+		Select("tags.*, " + tagPostCountExpr + " AS post_count").
+		Where(tagPostCountExpr + " > 0")
+	rows, meta, err := utils.Paginate(c, pagination, query, sortKey, "tags.id", func(row tagListRow) (interface{}, uint) {
+		if sortKey.IsNumber {
+			return row.PostCount, row.ID
+		}
+		return row.Slug, row.ID
+	})
+	if err != nil {
+		respondListError(c, err, "Could not retrieve tags")
+		return
+	}
+
+	responseTags := make([]responses.TagUsageResponse, 0, len(rows))
+	for _, row := range rows {
+		responseTags = append(responseTags, responses.TagUsageResponse{ID: row.ID, Name: row.Name, Slug: row.Slug, PostCount: row.PostCount})
+	}
+
+	utils.CreatePaginatedResponse(c, http.StatusOK, "Tags retrieved successfully", responseTags, meta)
+}
+
+// GetTagPosts godoc
+// @Summary Retrieve posts with a tag
+// @Description Get a paginated list of posts carrying a tag; accepts the same filters and sort options as GET /posts
+// @Tags Tags
+// @Produce json
+// @Param slug path string true "Tag slug"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Items per page (default 20, max 100)"
+// @Param cursor query string false "meta.next_cursor from the previous response"
+// @Param sort query string false "Sort order" Enums(newest, oldest, most_reacted, most_commented)
+// @Success 200 {object} responses.PostsResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid parameters"
+// @Failure 404 {object} responses.ErrorResponse "Tag not found"
+// @Failure 500 {object} responses.ErrorResponse "Could not retrieve posts"
+// @Router /tags/{slug}/posts [get]
+func GetTagPosts(c *gin.Context) {
+	var tag models.Tag
+	if err := database.DB.Where("slug = ?", c.Param("slug")).First(&tag).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, errTagNotFound.Error(), nil)
+		return
+	}
+
+	listPosts(c, database.DB.Model(&models.Post{}).Where("posts.id IN (SELECT post_id FROM post_tags WHERE tag_id = ?)", tag.ID), false)
+}
+
+// UpdateTag godoc
+// @Summary Rename a tag
+// @Description Change a tag's name and slug. Omitting slug derives it from the new name. If another tag already has the slug, the request is rejected; merge the tags instead. Requires the Editor or Admin role.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param slug path string true "Tag slug"
+// @Param tag body requests.UpdateTagRequest true "Tag information"
+// @Success 200 {object} responses.TagResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid input"
+// @Failure 403 {object} responses.ErrorResponse "Forbidden"
+// @Failure 404 {object} responses.ErrorResponse "Tag not found"
+// @Failure 409 {object} responses.ErrorResponse "Slug is already in use"
+// @Failure 500 {object} responses.ErrorResponse "Could not update tag"
+// @Router /tags/{slug} [put]
+func UpdateTag(c *gin.Context) {
+	var input requests.UpdateTagRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	name := normalizeTagName(input.Name)
+	slug := utils.Slugify(name)
+	if input.Slug != "" {
+		slug = utils.Slugify(input.Slug)
+	}
+	if slug == "" {
+		utils.CreateResponse(c, http.StatusBadRequest, errInvalidTag.Error(), nil)
+		return
+	}
+
+	var tag models.Tag
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("slug = ?", c.Param("slug")).First(&tag).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errTagNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.Tag{}).Where("slug = ? AND id <> ?", slug, tag.ID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errTagSlugTaken
+		}
+
+		tag.Name = name
+		tag.Slug = slug
+		if err := tx.Save(&tag).Error; err != nil {
+			return err
+		}
+		return bumpTagPostVersions(tx, tag.ID)
+	})
+	if err != nil {
+		respondTagWriteError(c, err, "Could not update tag")
+		return
+	}
+
+	events.PublishContentChanged()
+
+	utils.CreateResponse(c, http.StatusOK, "Tag updated successfully", buildTagResponse(tag))
+}
+
+// MergeTag godoc
+// @Summary Merge a tag into another one
+// @Description Move the tag from every post that carries it to the target tag, then delete it. Requires the Editor or Admin role.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param slug path string true "Source tag slug"
+// @Param merge body requests.MergeTagRequest true "Target tag"
+// @Success 200 {object} responses.TagMergeResponse
+// @Failure 400 {object} responses.ErrorResponse "Invalid input"
+// @Failure 403 {object} responses.ErrorResponse "Forbidden"
+// @Failure 404 {object} responses.ErrorResponse "Tag not found"
+// @Failure 500 {object} responses.ErrorResponse "Could not merge tags"
+// @Router /tags/{slug}/merge [post]
+func MergeTag(c *gin.Context) {
+	var input requests.MergeTagRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if input.Target == c.Param("slug") {
+		utils.CreateResponse(c, http.StatusBadRequest, errMergeTagIntoSelf.Error(), nil)
+		return
+	}
+
+	var target models.Tag
+	var movedPosts int64
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var source models.Tag
+		if err := tx.Where("slug = ?", c.Param("slug")).First(&source).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errTagNotFound
+			}
+			return err
+		}
+		if err := tx.Where("slug = ?", input.Target).First(&target).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errMergeTagNotFound
+			}
+			return err
+		}
+
+		if err := bumpTagPostVersions(tx, source.ID); err != nil {
+			return err
+		}
+		// Hedef etiketi zaten taşıyan postlar için join satırı tekrarlanmaz
+		result := tx.Exec(`INSERT INTO post_tags (post_id, tag_id)
+			SELECT post_id, ? FROM post_tags
+			WHERE tag_id = ? AND post_id NOT IN (SELECT post_id FROM post_tags WHERE tag_id = ?)`,
+			target.ID, source.ID, target.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		movedPosts = result.RowsAffected
+
+		if err := tx.Exec("DELETE FROM post_tags WHERE tag_id = ?", source.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&source).Error
+	})
+	if err != nil {
+		respondTagWriteError(c, err, "Could not merge tags")
+		return
+	}
+
+	events.PublishContentChanged()
+
+	response := responses.TagMergeResponse{
+		Target:     buildTagResponse(target),
+		MovedPosts: movedPosts,
+	}
+	utils.CreateResponse(c, http.StatusOK, "Tags merged successfully", response)
+}
+
+// normalizeTagName baştaki # işaretlerini atar, boşlukları tek boşluğa indirir
+// ve küçük harfe çevirir; "  #Go   Lang " → "go lang".
+func normalizeTagName(name string) string {
+	name = strings.TrimLeft(strings.TrimSpace(name), "#")
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// resolveTags etiket adlarını normalize eder, aynı slug'a inenleri birleştirir
+// ve olmayan etiketleri oluşturur. Etiketler ilk görüldükleri sırayla döner.
+func resolveTags(tx *gorm.DB, names []string) ([]models.Tag, error) {
+	wanted := make([]models.Tag, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = normalizeTagName(name)
+		slug := utils.Slugify(name)
+		if slug == "" {
+			return nil, errInvalidTag
+		}
+		if seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		wanted = append(wanted, models.Tag{Name: name, Slug: slug})
+	}
+	if len(wanted) == 0 {
+		return []models.Tag{}, nil
+	}
+
+	// Aynı anda oluşturulan etiketler çakışırsa var olan kayıt kullanılır
+	if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "slug"}}, DoNothing: true}).Create(&wanted).Error; err != nil {
+		return nil, err
+	}
+
+	slugs := make([]string, 0, len(wanted))
+	for _, tag := range wanted {
+		slugs = append(slugs, tag.Slug)
+	}
+	var existing []models.Tag
+	if err := tx.Where("slug IN ?", slugs).Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	bySlug := make(map[string]models.Tag, len(existing))
+	for _, tag := range existing {
+		bySlug[tag.Slug] = tag
+	}
+
+	tags := make([]models.Tag, 0, len(slugs))
+	for _, slug := range slugs {
+		tags = append(tags, bySlug[slug])
+	}
+	return tags, nil
+}
+
+// respondTagWriteError etiket hatalarını uygun durum koduna çevirir.
+func respondTagWriteError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, errTagNotFound), errors.Is(err, errMergeTagNotFound):
+		utils.CreateResponse(c, http.StatusNotFound, err.Error(), nil)
+	case errors.Is(err, errInvalidTag):
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+	case errors.Is(err, errTagSlugTaken), errors.Is(err, gorm.ErrDuplicatedKey):
+		utils.CreateResponse(c, http.StatusConflict, errTagSlugTaken.Error(), nil)
+	default:
+		utils.CreateResponse(c, http.StatusInternalServerError, fallback, nil)
+	}
+}
+
+func buildTagResponse(tag models.Tag) responses.TagResponse {
+	return responses.TagResponse{ID: tag.ID, Name: tag.Name, Slug: tag.Slug}
+}
+
+// bumpTagPostVersions etiketi değişen postların sürümünü artırır; böylece
+// önbellekteki ETag'ler geçersizleşir.
+func bumpTagPostVersions(tx *gorm.DB, tagID uint) error {
+	return tx.Model(&models.Post{}).
+		Where("id IN (SELECT post_id FROM post_tags WHERE tag_id = ?)", tagID).
+		UpdateColumn("version", bumpVersion).Error
+}
@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/models"
+	"blog-platform/requests"
+	"blog-platform/responses"
+	"blog-platform/utils"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// personalAccessTokenPrefixLength listelemede gösterilen token başlangıcının uzunluğudur.
+const personalAccessTokenPrefixLength = 12
+
+// CreatePersonalAccessToken godoc
+// @Summary Personal access token oluştur
+// @Description CI ve betikler için kapsamı sınırlı bir API token'ı oluşturur. Token yalnızca bu yanıtta gösterilir; sunucuda özeti saklanır. Kullanılabilir kapsamlar: posts, comments, reactions, categories ve user kaynakları için :read ve :write.
+// @Tags Tokens
+// @Accept json
+// @Produce json
+// @Param request body requests.CreatePersonalAccessTokenRequest true "Token bilgileri"
+// @Success 201 {object} responses.CreatedPersonalAccessTokenResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz istek veya kapsam"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/tokens [post]
+func CreatePersonalAccessToken(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var request requests.CreatePersonalAccessTokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var scopes []string
+	seen := map[string]bool{}
+	for _, scope := range request.Scopes {
+		scope = strings.TrimSpace(scope)
+		if !utils.IsValidScope(scope) {
+			utils.CreateResponse(c, http.StatusBadRequest, "Invalid scope: "+scope, nil)
+			return
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+
+	secret, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not generate token", nil)
+		return
+	}
+	plainToken := utils.PersonalAccessTokenPrefix + secret
+
+	token := models.PersonalAccessToken{
+		UserID:    currentUser.ID,
+		Name:      request.Name,
+		Prefix:    plainToken[:personalAccessTokenPrefixLength],
+		TokenHash: utils.HashToken(plainToken),
+		Scopes:    strings.Join(scopes, " "),
+	}
+	if request.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, request.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := database.DB.Create(&token).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not create token", nil)
+		return
+	}
+
+	response := responses.CreatedPersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: buildPersonalAccessTokenResponse(token),
+		Token:                       plainToken,
+	}
+	utils.CreateResponse(c, http.StatusCreated, "Token created, copy it now as it will not be shown again", response)
+}
+
+// GetPersonalAccessTokens godoc
+// @Summary Personal access token'ları listele
+// @Description Giriş yapmış kullanıcının token'larını son kullanım zamanlarıyla birlikte listeler; token değerleri gösterilmez
+// @Tags Tokens
+// @Produce json
+// @Success 200 {array} responses.PersonalAccessTokenResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/tokens [get]
+func GetPersonalAccessTokens(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var tokens []models.PersonalAccessToken
+	if err := database.DB.Where("user_id = ?", currentUser.ID).Order("created_at DESC").Find(&tokens).Error; err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not retrieve tokens", nil)
+		return
+	}
+
+	tokenResponses := make([]responses.PersonalAccessTokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		tokenResponses = append(tokenResponses, buildPersonalAccessTokenResponse(token))
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Tokens retrieved successfully", tokenResponses)
+}
+
+// RevokePersonalAccessToken godoc
+// @Summary Personal access token'ı iptal et
+// @Description Token'ı kalıcı olarak geçersiz kılar; kayıt son kullanım bilgisiyle birlikte listede kalır
+// @Tags Tokens
+// @Produce json
+// @Param token_id path int true "Token ID"
+// @Success 200 {object} responses.MessageResponse "Token iptal edildi"
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 404 {object} responses.ErrorResponse "Token bulunamadı"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/me/tokens/{token_id} [delete]
+func RevokePersonalAccessToken(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	var token models.PersonalAccessToken
+	if err := database.DB.Where("id = ? AND user_id = ?", c.Param("token_id"), currentUser.ID).First(&token).Error; err != nil {
+		utils.CreateResponse(c, http.StatusNotFound, "Token not found", nil)
+		return
+	}
+
+	if token.RevokedAt == nil {
+		if err := database.DB.Model(&token).Update("revoked_at", time.Now()).Error; err != nil {
+			utils.CreateResponse(c, http.StatusInternalServerError, "Could not revoke token", nil)
+			return
+		}
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Token revoked successfully", nil)
+}
+
+func buildPersonalAccessTokenResponse(token models.PersonalAccessToken) responses.PersonalAccessTokenResponse {
+	return responses.PersonalAccessTokenResponse{
+		ID:         token.ID,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     token.ScopeList(),
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
@@ -5,24 +5,32 @@ import (
 	"blog-platform/models"
 	"blog-platform/requests"
 	"blog-platform/responses"
+	"blog-platform/spam"
 	"blog-platform/utils"
+	"errors"
+	"fmt"
+	"log"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 // LoginUser godoc
 // @Summary Kullanıcı girişi
-// @Description Kullanıcı email ve şifre ile giriş yapar
+// @Description Kullanıcı email ve şifre ile giriş yapar. İki adımlı doğrulama etkinse token yerine mfa_token döner ve giriş /users/login/mfa ile tamamlanır. Tekrarlanan hatalı denemelerde artan bekleme süresi ve geçici kilitlenme uygulanır.
 // @Tags User
 // @Accept json
 // @Produce json
 // @Param user body requests.UserLoginRequest true "Giriş bilgileri"
 // @Success 200 {object} responses.LoginResponse
 // @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
-// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 401 {object} responses.ErrorResponse "Geçersiz email veya şifre"
+// @Failure 429 {object} responses.ErrorResponse "Çok fazla hatalı deneme, Retry-After başlığındaki süre kadar bekleyin"
 // @Router /users/login [post]
 func LoginUser(c *gin.Context) {
 	var input requests.UserLoginRequest
@@ -31,28 +39,147 @@ func LoginUser(c *gin.Context) {
 		return
 	}
 
+	input.Email = normalizeEmail(input.Email)
+	accountKey, ipKey := accountThrottleKey(input.Email), ipThrottleKey(c.ClientIP())
+	if wait := loginRetryAfter(accountKey, ipKey); wait > 0 {
+		respondTooManyAttempts(c, wait)
+		return
+	}
+
+	// Email kayıtlı olsun olmasın aynı mesaj döner ve bcrypt karşılaştırması yapılır
 	var user models.User
-	if err := database.DB.Where("email = ?", input.Email).First(&user).Error; err != nil {
-		utils.CreateResponse(c, http.StatusUnauthorized, "User not found", nil)
+	if err := database.DB.Preload("Roles").Where("email = ?", input.Email).First(&user).Error; err != nil {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(input.Password))
+		recordLoginFailure(c, nil, accountKey, ipKey)
+		utils.CreateResponse(c, http.StatusUnauthorized, "Invalid email or password", nil)
 		return
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(input.Password)); err != nil {
-		utils.CreateResponse(c, http.StatusUnauthorized, "Incorrect password", nil)
+		recordLoginFailure(c, &user.ID, accountKey, ipKey)
+		utils.CreateResponse(c, http.StatusUnauthorized, "Invalid email or password", nil)
 		return
 	}
 
-	token, err := utils.GenerateJWT(user.ID)
+	resetLoginThrottle(accountKey)
+	completeLogin(c, user)
+}
+
+// RefreshToken godoc
+// @Summary Access token yenile
+// @Description Refresh token'ı tek kullanımlık olarak yeni bir access/refresh token çiftiyle değiştirir. Daha önce kullanılmış bir token gönderilirse oturumun tamamı iptal edilir.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param token body requests.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} responses.LoginResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 401 {object} responses.ErrorResponse "Geçersiz veya iptal edilmiş token"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/refresh [post]
+func RefreshToken(c *gin.Context) {
+	var input requests.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	var record models.RefreshToken
+	if err := database.DB.Preload("Session").Where("token_hash = ?", utils.HashToken(input.RefreshToken)).First(&record).Error; err != nil {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Invalid refresh token", nil)
+		return
+	}
+
+	if record.Session.RevokedAt != nil || time.Now().After(record.ExpiresAt) {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Refresh token expired or revoked", nil)
+		return
+	}
+
+	var tokens responses.LoginResponse
+	reused := false
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL", record.ID).
+			Update("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Token daha önce kullanılmışsa çalınmış olabilir; aileyi tamamen iptal et
+		if result.RowsAffected == 0 {
+			reused = true
+			return revokeSession(tx, record.SessionID)
+		}
+
+		if err := tx.Model(&record.Session).Update("last_used_at", now).Error; err != nil {
+			return err
+		}
+
+		var err error
+		tokens, err = issueTokens(tx, record.Session)
+		return err
+	})
 	if err != nil {
-		utils.CreateResponse(c, http.StatusInternalServerError, "Could not generate token", nil)
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not refresh token", nil)
+		return
+	}
+
+	if reused {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Refresh token reuse detected, session revoked", nil)
+		return
+	}
+
+	tokens.Message = "Token refreshed successfully"
+	utils.CreateResponse(c, http.StatusOK, "Token refreshed successfully", tokens)
+}
+
+// LogoutUser godoc
+// @Summary Oturumu kapat
+// @Description Mevcut oturumu ve ona ait refresh token ailesini iptal eder
+// @Tags User
+// @Produce json
+// @Success 200 {object} responses.MessageResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/logout [post]
+func LogoutUser(c *gin.Context) {
+	sessionID, exists := c.Get("session_id")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
 		return
 	}
 
-	loginResponse := responses.LoginResponse{
-		Message: "Login successful",
-		Token:   token,
+	if err := revokeSession(database.DB, sessionID.(uuid.UUID)); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not log out", nil)
+		return
 	}
-	utils.CreateResponse(c, http.StatusOK, "Login successful", loginResponse)
+
+	utils.CreateResponse(c, http.StatusOK, "Logged out successfully", nil)
+}
+
+// LogoutAllSessions godoc
+// @Summary Tüm oturumları kapat
+// @Description Kullanıcının tüm cihazlardaki oturumlarını iptal eder
+// @Tags User
+// @Produce json
+// @Success 200 {object} responses.MessageResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/logout/all [post]
+func LogoutAllSessions(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	if err := revokeUserSessions(database.DB, user.(models.User).ID); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not log out", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Logged out from all sessions", nil)
 }
 
 // RegisterUser godoc
@@ -64,6 +191,8 @@ func LoginUser(c *gin.Context) {
 // @Param user body requests.UserRegisterRequest true "Kayıt bilgileri"
 // @Success 200 {object} responses.RegisterResponse
 // @Failure 400 {object} responses.ErrorResponse "Geçersiz veri"
+// @Failure 409 {object} responses.ErrorResponse "Kullanıcı adı veya email kullanımda"
+// @Failure 422 {object} responses.ErrorResponse "Kayıt spam olarak reddedildi"
 // @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
 // @Router /users/register [post]
 func RegisterUser(c *gin.Context) {
@@ -73,6 +202,35 @@ func RegisterUser(c *gin.Context) {
 		return
 	}
 
+	if err := validateUsername(input.Username); err != nil {
+		utils.CreateResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	input.Email = normalizeEmail(input.Email)
+
+	result, err := spam.Evaluate(c.Request.Context(), spam.Submission{
+		Kind:      spam.KindRegistration,
+		IP:        c.ClientIP(),
+		Username:  input.Username,
+		Email:     input.Email,
+		Honeypot:  input.Honeypot,
+		FormToken: input.FormToken,
+	})
+	if err != nil {
+		log.Printf("Spam check failed for registration of %s: %v", input.Username, err)
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not create user", nil)
+		return
+	}
+	if result.Action == spam.ActionReject {
+		writeAuditLog(models.AuditLog{
+			Action:    models.AuditActionSpamRejected,
+			IPAddress: c.ClientIP(),
+			Details:   fmt.Sprintf("registration of %s rejected: %s", input.Username, result.Reason()),
+		})
+		utils.CreateResponse(c, http.StatusUnprocessableEntity, "Registration was rejected", nil)
+		return
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
 	if err != nil {
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not hash password", nil)
@@ -87,16 +245,45 @@ func RegisterUser(c *gin.Context) {
 		Email:     input.Email,
 		Password:  string(hashedPassword),
 	}
+	// Şüpheli kayıtlar oluşturulur ancak yorumları moderatör onayına kalır
+	if result.Action == spam.ActionModerate {
+		now := time.Now()
+		user.SpamFlaggedAt = &now
+	}
 
 	if err := database.DB.Create(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			utils.CreateResponse(c, http.StatusConflict, "Username or email is already taken", nil)
+			return
+		}
 		utils.CreateResponse(c, http.StatusInternalServerError, "Could not create user", nil)
 		return
 	}
 
+	// Kayıt gönderimi hesap silinirken IP adresiyle birlikte silinebilsin diye
+	// kullanıcıya bağlanır
+	if err := spam.LinkUser(database.DB, result.SubmissionID, user.ID); err != nil {
+		log.Printf("Could not link spam check record to user %s: %v", user.ID, err)
+	}
+
+	if user.SpamFlaggedAt != nil {
+		writeAuditLog(models.AuditLog{
+			Action:    models.AuditActionSpamFlagged,
+			UserID:    &user.ID,
+			IPAddress: c.ClientIP(),
+			Details:   "registration flagged as possible spam: " + result.Reason(),
+		})
+	}
+
+	if err := sendVerificationEmail(user); err != nil {
+		log.Printf("Could not send verification email to %s: %v", user.ID, err)
+	}
+
 	userResponse := responses.UserResponse{
-		ID:       user.ID,
-		Username: user.Username,
-		Email:    user.Email,
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
 	}
 
 	registerResponse := responses.RegisterResponse{
@@ -105,3 +292,9 @@ func RegisterUser(c *gin.Context) {
 	}
 	utils.CreateResponse(c, http.StatusOK, "User registered successfully", registerResponse)
 }
+
+// normalizeEmail email adresini karşılaştırma ve saklama için küçük harfe
+// çevirir; adresler büyük/küçük harf farkıyla ikinci bir hesap açamaz.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"blog-platform/models"
+	"blog-platform/utils"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var errInvalidUserToken = errors.New("invalid or expired token")
+
+// createUserToken kullanıcı için yeni bir tek kullanımlık token üretir. Aynı
+// amaçla daha önce üretilmiş ve kullanılmamış token'lar geçersiz kılınır.
+func createUserToken(tx *gorm.DB, userID uuid.UUID, purpose string, ttl time.Duration) (string, error) {
+	if err := invalidateUserTokens(tx, userID, purpose); err != nil {
+		return "", err
+	}
+
+	token, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	record := models.UserToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := tx.Create(&record).Error; err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// consumeUserToken token'ı doğrular ve kullanılmış olarak işaretler.
+// Eşzamanlı iki istekten yalnızca biri başarılı olur.
+func consumeUserToken(tx *gorm.DB, token string, purpose string) (models.UserToken, error) {
+	var record models.UserToken
+	if err := tx.Where("token_hash = ? AND purpose = ?", utils.HashToken(token), purpose).First(&record).Error; err != nil {
+		return record, errInvalidUserToken
+	}
+
+	if record.UsedAt != nil || time.Now().After(record.ExpiresAt) {
+		return record, errInvalidUserToken
+	}
+
+	result := tx.Model(&models.UserToken{}).
+		Where("id = ? AND used_at IS NULL", record.ID).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return record, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return record, errInvalidUserToken
+	}
+	return record, nil
+}
+
+func invalidateUserTokens(tx *gorm.DB, userID uuid.UUID, purpose string) error {
+	return tx.Model(&models.UserToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", time.Now()).Error
+}
+
+// appURL e-postalardaki bağlantılar için APP_BASE_URL'e göre tam adres üretir.
+func appURL(path string) string {
+	base := os.Getenv("APP_BASE_URL")
+	if base == "" {
+		base = "http://localhost:8080"
+	}
+	return strings.TrimSuffix(base, "/") + path
+}
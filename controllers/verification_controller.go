@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"blog-platform/database"
+	"blog-platform/mailer"
+	"blog-platform/models"
+	"blog-platform/utils"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// VerifyEmail godoc
+// @Summary Email adresini doğrula
+// @Description Kayıt sonrası gönderilen doğrulama token'ı ile kullanıcının email adresini doğrular
+// @Tags User
+// @Produce json
+// @Param token query string true "Doğrulama token'ı"
+// @Success 200 {object} responses.MessageResponse
+// @Failure 400 {object} responses.ErrorResponse "Geçersiz veya süresi dolmuş token"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/verify [get]
+func VerifyEmail(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		utils.CreateResponse(c, http.StatusBadRequest, "Token is required", nil)
+		return
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		record, err := consumeUserToken(tx, token, models.TokenPurposeEmailVerification)
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.User{}).Where("id = ?", record.UserID).Updates(map[string]interface{}{
+			"email_verified": true,
+			"verified_at":    time.Now(),
+		}).Error
+	})
+	if errors.Is(err, errInvalidUserToken) {
+		utils.CreateResponse(c, http.StatusBadRequest, "Invalid or expired verification token", nil)
+		return
+	}
+	if err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not verify email", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Email verified successfully", nil)
+}
+
+// ResendVerificationEmail godoc
+// @Summary Doğrulama emailini tekrar gönder
+// @Description Giriş yapmış ve email adresi henüz doğrulanmamış kullanıcıya yeni bir doğrulama bağlantısı gönderir
+// @Tags User
+// @Produce json
+// @Success 200 {object} responses.MessageResponse
+// @Failure 401 {object} responses.ErrorResponse "Yetkisiz"
+// @Failure 409 {object} responses.ErrorResponse "Email zaten doğrulanmış"
+// @Failure 500 {object} responses.ErrorResponse "Sunucu hatası"
+// @Router /users/verify/resend [post]
+func ResendVerificationEmail(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		utils.CreateResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	currentUser := user.(models.User)
+
+	if currentUser.EmailVerified {
+		utils.CreateResponse(c, http.StatusConflict, "Email is already verified", nil)
+		return
+	}
+
+	if err := sendVerificationEmail(currentUser); err != nil {
+		utils.CreateResponse(c, http.StatusInternalServerError, "Could not send verification email", nil)
+		return
+	}
+
+	utils.CreateResponse(c, http.StatusOK, "Verification email sent", nil)
+}
+
+// sendVerificationEmail yeni bir doğrulama token'ı üretir ve e-postayı arka planda gönderir.
+func sendVerificationEmail(user models.User) error {
+	ttl := utils.GetEnvDuration("EMAIL_VERIFICATION_TTL", 48*time.Hour)
+	token, err := createUserToken(database.DB, user.ID, models.TokenPurposeEmailVerification, ttl)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		link := appURL("/users/verify?token=" + url.QueryEscape(token))
+		err := mailer.Send(mailer.Message{
+			To:      user.Email,
+			Subject: "Verify your email address",
+			Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below. It expires in %s.\n\n%s\n",
+				user.Username, ttl, link),
+		})
+		if err != nil {
+			log.Printf("Could not send verification email: %v", err)
+		}
+	}()
+	return nil
+}
@@ -1,9 +1,15 @@
 package database
 
 import (
+	"blog-platform/markup"
 	"blog-platform/models"
+	"blog-platform/search"
+	"blog-platform/spam"
+	"blog-platform/utils"
+	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
@@ -19,19 +25,227 @@ func InitDatabase() {
 	switch dbType {
 	case "postgres":
 		dsn := os.Getenv("DATABASE_URL")
-		DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+		DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	default:
-		DB, err = gorm.Open(sqlite.Open("test.db"), &gorm.Config{})
+		DB, err = gorm.Open(sqlite.Open("test.db"), &gorm.Config{TranslateError: true})
 	}
 
 	if err != nil {
 		log.Fatalf("failed to connect database: %v", err)
 	}
 
-	err = DB.AutoMigrate(&models.User{}, &models.Post{}, &models.Comment{}, &models.Reaction{})
+	if err := backfillCategorySlugs(DB); err != nil {
+		log.Fatalf("failed to backfill category slugs: %v", err)
+	}
+
+	if err := backfillPostStatus(DB); err != nil {
+		log.Fatalf("failed to backfill post status: %v", err)
+	}
+
+	if err := backfillPostSlugs(DB); err != nil {
+		log.Fatalf("failed to backfill post slugs: %v", err)
+	}
+
+	if err := backfillEmailVerified(DB); err != nil {
+		log.Fatalf("failed to backfill email verification: %v", err)
+	}
+
+	if err := prepareUserUniqueIndexes(DB); err != nil {
+		log.Fatalf("failed to prepare user indexes: %v", err)
+	}
+
+	err = DB.AutoMigrate(&models.User{}, &models.Post{}, &models.PostSlugRedirect{}, &models.PostStatusChange{}, &models.PostRevision{}, &models.Category{}, &models.Tag{}, &models.Comment{}, &models.CommentRevision{}, &models.CommentModeration{}, &models.Reaction{}, &models.Session{}, &models.RefreshToken{}, &models.PersonalAccessToken{}, &models.UserToken{}, &models.LoginThrottle{}, &models.AuditLog{}, &models.RecoveryCode{}, &models.Role{}, &models.UserIdentity{}, &models.OAuthState{})
 	if err != nil {
 		log.Fatalf("failed to migrate database: %v", err)
 	}
 
+	if err := renderMissingContentHTML(DB); err != nil {
+		log.Fatalf("failed to render content html: %v", err)
+	}
+
+	if err := search.Init(DB); err != nil {
+		log.Fatalf("failed to initialize search index: %v", err)
+	}
+	if err := spam.Init(DB); err != nil {
+		log.Fatalf("failed to initialize spam detection: %v", err)
+	}
+
 	log.Println("Database connection successfully established")
 }
+
+// backfillCategorySlugs slug sütunu eklenmeden önce oluşturulmuş kategorilere
+// benzersiz slug atar; aksi halde benzersiz index boş değerler yüzünden oluşturulamaz.
+func backfillCategorySlugs(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.Category{}) || migrator.HasColumn(&models.Category{}, "Slug") {
+		return nil
+	}
+	if err := migrator.AddColumn(&models.Category{}, "Slug"); err != nil {
+		return err
+	}
+
+	var categories []models.Category
+	if err := db.Unscoped().Select("id", "name").Order("id").Find(&categories).Error; err != nil {
+		return err
+	}
+	for _, category := range categories {
+		slug, err := utils.UniqueSlug(db.Unscoped(), &models.Category{}, utils.SlugWithFallback(category.Name, "category"), category.ID)
+		if err != nil {
+			return err
+		}
+		if err := db.Unscoped().Model(&models.Category{}).Where("id = ?", category.ID).Update("slug", slug).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareUserUniqueIndexes kullanıcı adı ve e-posta için benzersiz index
+// oluşturulmadan önce e-postaları küçük harfe çevirir. Çakışan kayıtlar
+// otomatik olarak birleştirilemeyeceği için listelenir ve hata döner;
+// aksi halde AutoMigrate anlaşılmaz bir index hatasıyla başarısız olur.
+func prepareUserUniqueIndexes(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&models.User{}) {
+		return nil
+	}
+
+	var duplicates []struct {
+		Column string
+		Value  string
+		IDs    string
+	}
+	err := db.Raw(`SELECT 'username' AS "column", username AS value, ` + groupConcat(db, "id") + ` AS ids FROM users GROUP BY username HAVING COUNT(*) > 1
+		UNION ALL
+		SELECT 'email', LOWER(email), ` + groupConcat(db, "id") + ` FROM users GROUP BY LOWER(email) HAVING COUNT(*) > 1`).
+		Scan(&duplicates).Error
+	if err != nil {
+		return err
+	}
+	if len(duplicates) > 0 {
+		for _, duplicate := range duplicates {
+			log.Printf("Duplicate %s %q used by users %s", duplicate.Column, duplicate.Value, duplicate.IDs)
+		}
+		return fmt.Errorf("%d duplicate username(s) or email address(es) found; rename or remove the listed users and restart", len(duplicates))
+	}
+
+	return db.Exec("UPDATE users SET email = LOWER(email) WHERE email <> LOWER(email)").Error
+}
+
+// groupConcat sürücüye göre değerleri virgülle birleştiren SQL ifadesini döner.
+func groupConcat(db *gorm.DB, column string) string {
+	if db.Dialector.Name() == "postgres" {
+		return "STRING_AGG(" + column + "::text, ', ')"
+	}
+	return "GROUP_CONCAT(" + column + ", ', ')"
+}
+
+// backfillPostSlugs slug sütunu eklenmeden önce oluşturulmuş postlara
+// başlıklarından benzersiz slug atar.
+func backfillPostSlugs(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.Post{}) || migrator.HasColumn(&models.Post{}, "Slug") {
+		return nil
+	}
+	if err := migrator.AddColumn(&models.Post{}, "Slug"); err != nil {
+		return err
+	}
+
+	var posts []models.Post
+	if err := db.Unscoped().Select("id", "title").Order("id").Find(&posts).Error; err != nil {
+		return err
+	}
+	for _, post := range posts {
+		slug, err := utils.UniqueSlug(db.Unscoped(), &models.Post{}, utils.SlugWithFallback(post.Title, "post"), post.ID)
+		if err != nil {
+			return err
+		}
+		if err := db.Unscoped().Model(&models.Post{}).Where("id = ?", post.ID).UpdateColumn("slug", slug).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillPostStatus durum sütunundan önce oluşturulmuş postları yayımlanmış
+// sayar; bu postlar zaten herkese açıktı.
+func backfillPostStatus(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.Post{}) || migrator.HasColumn(&models.Post{}, "Status") {
+		return nil
+	}
+	for _, column := range []string{"Status", "PublishedAt"} {
+		if !migrator.HasColumn(&models.Post{}, column) {
+			if err := migrator.AddColumn(&models.Post{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return db.Unscoped().Model(&models.Post{}).Where("1 = 1").Updates(map[string]interface{}{
+		"status":       models.PostStatusPublished,
+		"published_at": gorm.Expr("created_at"),
+	}).Error
+}
+
+// backfillEmailVerified doğrulama sütunundan önce açılmış hesapları doğrulanmış
+// sayar; aksi halde REQUIRE_VERIFIED_EMAIL açıldığında mevcut kullanıcılar
+// kendilerine hiç gönderilmemiş bir doğrulama e-postasını beklemek zorunda kalır.
+func backfillEmailVerified(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.User{}) || migrator.HasColumn(&models.User{}, "EmailVerified") {
+		return nil
+	}
+	for _, column := range []string{"EmailVerified", "VerifiedAt"} {
+		if !migrator.HasColumn(&models.User{}, column) {
+			if err := migrator.AddColumn(&models.User{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return db.Model(&models.User{}).Where("1 = 1").Updates(map[string]interface{}{
+		"email_verified": true,
+		"verified_at":    time.Now(),
+	}).Error
+}
+
+// renderMissingContentHTML content_html sütunu eklenmeden önce oluşturulmuş
+// post ve yorumların HTML'ini bir kez üretir. id öznitelikleri silinmeden önce
+// üretilmiş yorum HTML'i de yeniden üretilir. Sonraki açılışlarda eşleşen
+// kayıt kalmadığından hiçbir şey yapmaz.
+func renderMissingContentHTML(db *gorm.DB) error {
+	var posts []models.Post
+	err := db.Unscoped().Select("id", "content", "content_format").
+		Where("(content_html IS NULL OR content_html = '') AND content <> ''").
+		FindInBatches(&posts, 100, func(tx *gorm.DB, batch int) error {
+			for _, post := range posts {
+				rendered, err := markup.Render(post.ContentFormat, post.Content, markup.AudiencePost)
+				if err != nil {
+					return err
+				}
+				if err := db.Unscoped().Model(&models.Post{}).Where("id = ?", post.ID).UpdateColumn("content_html", rendered).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	var comments []models.Comment
+	return db.Unscoped().Select("id", "content", "content_format").
+		Where("(content_html IS NULL OR content_html = '' OR content_html LIKE ?) AND content <> ''", `% id="%`).
+		FindInBatches(&comments, 100, func(tx *gorm.DB, batch int) error {
+			for _, comment := range comments {
+				rendered, err := markup.Render(comment.ContentFormat, comment.Content, markup.AudienceComment)
+				if err != nil {
+					return err
+				}
+				if err := db.Unscoped().Model(&models.Comment{}).Where("id = ?", comment.ID).UpdateColumn("content_html", rendered).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
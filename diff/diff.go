@@ -0,0 +1,214 @@
+// Package diff iki metin arasındaki farkı satır veya kelime düzeyinde
+// Myers algoritmasıyla hesaplar.
+package diff
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Op türleri.
+const (
+	Equal  = "equal"
+	Insert = "insert"
+	Delete = "delete"
+)
+
+// maxEditDistance bu sayıdan fazla değişiklik içeren metinlerde algoritma
+// süreyi sınırlamak için durur ve tüm metni değişmiş sayar.
+const maxEditDistance = 2000
+
+// maxTokens karşılaştırılabilecek en fazla parça sayısıdır. Kelime farkı daha
+// uzun metinlerde satır farkına, satır farkı tüm metni değişmiş saymaya döner.
+const maxTokens = 20000
+
+// Op farkın bir parçasıdır. Aynı türdeki ardışık parçalar birleştirilir;
+// Equal ve Delete parçaları sırayla birleştirildiğinde eski metni, Equal ve
+// Insert parçaları yeni metni verir.
+type Op struct {
+	Type string
+	Text string
+}
+
+var wordPattern = regexp.MustCompile(`\s+|[^\s]+`)
+
+// Lines metinleri satır satır karşılaştırır. Satır sonları satıra dahildir.
+func Lines(a, b string) []Op {
+	return compute(strings.SplitAfter(a, "\n"), strings.SplitAfter(b, "\n"))
+}
+
+// Words metinleri kelime ve boşluk grupları halinde karşılaştırır.
+func Words(a, b string) []Op {
+	aWords, bWords := wordPattern.FindAllString(a, maxTokens+1), wordPattern.FindAllString(b, maxTokens+1)
+	if len(aWords) > maxTokens || len(bWords) > maxTokens {
+		return Lines(a, b)
+	}
+	return compute(aWords, bWords)
+}
+
+func compute(a, b []string) []Op {
+	a, b = dropEmpty(a), dropEmpty(b)
+	if len(a) > maxTokens || len(b) > maxTokens {
+		return merge(replaceAll(a, b))
+	}
+	return merge(diffRange(a, b))
+}
+
+// diffRange ortak baş ve son kısımları ayırır, kalan kısmı Myers ile
+// karşılaştırır.
+func diffRange(a, b []string) []Op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []Op
+	for _, token := range a[:prefix] {
+		ops = appendOp(ops, Equal, token)
+	}
+	ops = append(ops, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, token := range a[len(a)-suffix:] {
+		ops = appendOp(ops, Equal, token)
+	}
+	return ops
+}
+
+// myers en kısa düzenleme dizisini doğrusal bellekle bulur: düzenleme yolu
+// baştan ve sondan aynı anda aranır, iki arama buluştuğu noktada metinler
+// ikiye bölünür ve her yarı ayrı karşılaştırılır. Yalnızca iki uç nokta
+// dizisi tutulduğundan bellek kullanımı metin uzunluğuyla orantılıdır.
+func myers(a, b []string) []Op {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return replaceAll(a, b)
+	}
+
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	// Uzunluk farkı tekse yollar ileri aramada, çiftse geri aramada buluşur
+	delta := n - m
+	checkForward := delta%2 != 0
+	var forwardStart, forwardEnd, backwardStart, backwardEnd int
+
+	for d := 0; d < maxD && d <= maxEditDistance/2; d++ {
+		for k := -d + forwardStart; k <= d-forwardEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && forward[i-1] < forward[i+1]) {
+				x = forward[i+1]
+			} else {
+				x = forward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			forward[i] = x
+			switch {
+			case x > n:
+				forwardEnd += 2
+			case y > m:
+				forwardStart += 2
+			case checkForward:
+				j := offset + delta - k
+				if j >= 0 && j < len(backward) && backward[j] != -1 && x >= n-backward[j] {
+					return split(a, b, x, y)
+				}
+			}
+		}
+
+		for k := -d + backwardStart; k <= d-backwardEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -d || (k != d && backward[i-1] < backward[i+1]) {
+				x = backward[i+1]
+			} else {
+				x = backward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			backward[i] = x
+			switch {
+			case x > n:
+				backwardEnd += 2
+			case y > m:
+				backwardStart += 2
+			case !checkForward:
+				j := offset + delta - k
+				if j >= 0 && j < len(forward) && forward[j] != -1 {
+					forwardX := forward[j]
+					if forwardX >= n-x {
+						return split(a, b, forwardX, offset+forwardX-j)
+					}
+				}
+			}
+		}
+	}
+	return replaceAll(a, b)
+}
+
+// split metinleri ileri ve geri aramaların buluştuğu noktadan bölüp iki yarıyı
+// ayrı ayrı karşılaştırır.
+func split(a, b []string, x, y int) []Op {
+	return append(diffRange(a[:x], b[:y]), diffRange(a[x:], b[y:])...)
+}
+
+func replaceAll(a, b []string) []Op {
+	var ops []Op
+	for _, token := range a {
+		ops = appendOp(ops, Delete, token)
+	}
+	for _, token := range b {
+		ops = appendOp(ops, Insert, token)
+	}
+	return ops
+}
+
+func appendOp(ops []Op, opType, text string) []Op {
+	return append(ops, Op{Type: opType, Text: text})
+}
+
+// merge aynı türdeki ardışık parçaları birleştirir. Metinler parça parça
+// eklenmek yerine tek seferde birleştirilir; aksi halde uzun metinlerde her
+// ekleme tüm metni yeniden kopyalar.
+func merge(ops []Op) []Op {
+	merged := make([]Op, 0)
+	for start := 0; start < len(ops); {
+		end := start + 1
+		for end < len(ops) && ops[end].Type == ops[start].Type {
+			end++
+		}
+		texts := make([]string, 0, end-start)
+		for _, op := range ops[start:end] {
+			texts = append(texts, op.Text)
+		}
+		merged = append(merged, Op{Type: ops[start].Type, Text: strings.Join(texts, "")})
+		start = end
+	}
+	return merged
+}
+
+func dropEmpty(tokens []string) []string {
+	result := tokens[:0:0]
+	for _, token := range tokens {
+		if token != "" {
+			result = append(result, token)
+		}
+	}
+	return result
+}
@@ -15,6 +15,26 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Token doğrulamak için kullanılan açık anahtarları RFC 7517 formatında yayınlar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "JSON Web Key Set",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.JWKSet"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/role/add": {
             "post": {
                 "description": "Add a role to a specific user by user ID and role ID",
@@ -209,36 +229,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/category": {
-            "get": {
-                "description": "Get a list of all categories",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Categories"
-                ],
-                "summary": "Retrieve all categories",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.CategoryResponse"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Could not retrieve categories",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Create a new category with a given name",
+        "/admin/role/{role_id}/mfa": {
+            "put": {
+                "description": "Users holding a role that requires 2FA must enroll in TOTP before they can use authenticated endpoints",
                 "consumes": [
                     "application/json"
                 ],
@@ -246,17 +239,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Roles"
                 ],
-                "summary": "Create a new category",
+                "summary": "Require two-factor authentication for a role",
                 "parameters": [
                     {
-                        "description": "Category information",
-                        "name": "category",
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "role_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "2FA requirement",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/requests.CreateCategoryRequest"
+                            "$ref": "#/definitions/requests.SetRoleMFARequest"
                         }
                     }
                 ],
@@ -264,7 +264,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.CategoryResponse"
+                            "$ref": "#/definitions/responses.RoleResponse"
                         }
                     },
                     "400": {
@@ -273,14 +273,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Role not found",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Could not create category",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -288,21 +288,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/category/{category_id}": {
-            "get": {
-                "description": "Get a category by its ID",
+        "/admin/users/{user_id}/unlock": {
+            "post": {
+                "description": "Clears failed login attempts and any temporary lockout for the user's account and records the action in the audit log",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Admin"
                 ],
-                "summary": "Retrieve a single category",
+                "summary": "Unlock a user's login",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "category_id",
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     }
@@ -311,11 +311,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.CategoryResponse"
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Category not found",
+                        "description": "User not found",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -323,34 +329,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/comments/post/{post_id}": {
+        "/atom.xml": {
             "get": {
-                "description": "Post ID'ye göre yorumları getirir",
+                "description": "Son yayımlanmış postları Atom 1.0 olarak döner; her girdi postun güncellenme zamanını taşır. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
                 "produces": [
-                    "application/json"
+                    "text/xml"
                 ],
                 "tags": [
-                    "Comment"
+                    "Feed"
                 ],
-                "summary": "Belirli bir posta ait yorumları getir",
+                "summary": "Atom 1.0 beslemesi",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Atom belgesi",
                         "schema": {
-                            "$ref": "#/definitions/responses.CommentsResponse"
+                            "type": "string"
                         }
                     },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
                     "404": {
-                        "description": "Post bulunamadı",
+                        "description": "Yazar, kategori veya etiket bulunamadı",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -364,96 +378,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/comments/user": {
+        "/auth/oidc/callback": {
             "get": {
-                "description": "Giriş yapmış kullanıcıya ait tüm yorumları getirir",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Comment"
-                ],
-                "summary": "Kullanıcıya ait yorumları getir",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/responses.CommentsResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Yetkisiz",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Sunucu hatası",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/comments/{comment_id}": {
-            "put": {
-                "description": "Belirli bir yorumu günceller",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Authorization code'u doğrular, kullanıcıyı doğrulanmış email ile oluşturur veya mevcut hesaba bağlar, IdP gruplarını rollere eşler ve oturum token'larını döner. state, girişi başlatan tarayıcıya verilen oidc_state çereziyle eşleşmelidir. Email'i doğrulanmamış bir hesaba bağlanırken o hesabın şifresi, iki adımlı doğrulaması, oturumları ve token'ları kaldırılır.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Auth"
                 ],
-                "summary": "Mevcut bir yorumu güncelle",
+                "summary": "OIDC giriş dönüşü",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Comment ID",
-                        "name": "comment_id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Yorum bilgisi",
-                        "name": "comment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/requests.UpdateCommentRequest"
-                        }
+                        "type": "string",
+                        "description": "State",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.CommentResponse"
+                            "$ref": "#/definitions/responses.LoginResponse"
                         }
                     },
                     "400": {
-                        "description": "Geçersiz veri",
+                        "description": "Geçersiz veya süresi dolmuş state",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Yetkisiz",
+                        "description": "Kimlik doğrulanamadı",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "Erişim reddedildi",
+                        "description": "Email doğrulanmamış",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Yorum bulunamadı",
+                        "description": "OIDC yapılandırılmamış",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -465,68 +442,93 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Belirli bir yorumu siler",
-                "produces": [
-                    "application/json"
-                ],
+            }
+        },
+        "/auth/oidc/login": {
+            "get": {
+                "description": "Kullanıcıyı state, nonce ve PKCE parametreleriyle kimlik sağlayıcının giriş sayfasına yönlendirir ve state'i oidc_state çerezine yazar",
                 "tags": [
-                    "Comment"
-                ],
-                "summary": "Mevcut bir yorumu sil",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Comment ID",
-                        "name": "comment_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "OIDC ile giriş başlat",
                 "responses": {
-                    "200": {
-                        "description": "Yorum başarıyla silindi",
+                    "302": {
+                        "description": "Kimlik sağlayıcıya yönlendirme",
                         "schema": {
-                            "$ref": "#/definitions/responses.MessageResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Geçersiz veri",
+                    "404": {
+                        "description": "OIDC yapılandırılmamış",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Yetkisiz",
+                    "502": {
+                        "description": "Kimlik sağlayıcıya ulaşılamadı",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/category": {
+            "get": {
+                "description": "Get a paginated list of categories sorted by name, each with its breadcrumb",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Retrieve categories",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "Erişim reddedildi",
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "meta.next_cursor from the previous response",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CategoryResponse"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Yorum bulunamadı",
+                    "400": {
+                        "description": "Invalid pagination parameters",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Sunucu hatası",
+                        "description": "Could not retrieve categories",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/comments/{post_id}": {
+            },
             "post": {
-                "description": "Belirli bir posta yeni bir yorum ekler",
+                "description": "Create a new category, optionally nested under a parent. The slug is derived from the name unless one is given. Requires the Editor or Admin role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -534,24 +536,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Categories"
                 ],
-                "summary": "Yeni bir yorum oluştur",
+                "summary": "Create a new category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Yorum bilgisi",
-                        "name": "comment",
+                        "description": "Category information",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/requests.CreateCommentRequest"
+                            "$ref": "#/definitions/requests.CreateCategoryRequest"
                         }
                     }
                 ],
@@ -559,23 +554,35 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.CommentResponse"
+                            "$ref": "#/definitions/responses.CategoryResponse"
                         }
                     },
                     "400": {
-                        "description": "Geçersiz veri",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Yetkisiz",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug is already in use",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Sunucu hatası",
+                        "description": "Could not create category",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -583,69 +590,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts": {
+        "/category/tree": {
             "get": {
-                "description": "Tüm postları listeler",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Post"
-                ],
-                "summary": "Tüm postları getir",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/responses.PostsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Sunucu hatası",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Yeni bir post oluşturur",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get every category nested under its parent; siblings are sorted by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
-                ],
-                "summary": "Yeni bir post oluştur",
-                "parameters": [
-                    {
-                        "description": "Post bilgisi",
-                        "name": "post",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/requests.CreatePostRequest"
-                        }
-                    }
+                    "Categories"
                 ],
+                "summary": "Retrieve the category tree",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.PostResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Geçersiz veri",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CategoryTreeNode"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Sunucu hatası",
+                        "description": "Could not retrieve categories",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -653,21 +619,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{post_id}": {
+        "/category/{category_id}": {
             "get": {
-                "description": "ID ile tek bir postu getirir",
+                "description": "Get a category by its ID or slug, including its breadcrumb",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
+                    "Categories"
                 ],
-                "summary": "Belirli bir postu getir",
+                "summary": "Retrieve a single category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "type": "string",
+                        "description": "Category ID or slug",
+                        "name": "category_id",
                         "in": "path",
                         "required": true
                     }
@@ -676,11 +642,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.PostResponse"
+                            "$ref": "#/definitions/responses.CategoryResponse"
                         }
                     },
                     "404": {
-                        "description": "Post bulunamadı",
+                        "description": "Category not found",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -688,7 +654,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "ID ile mevcut bir postu günceller",
+                "description": "Replace a category's name, description and parent. Omitting parent_id moves the category to the root; omitting slug keeps the current one. Requires the Editor or Admin role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -696,24 +662,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
+                    "Categories"
                 ],
-                "summary": "Mevcut bir postu güncelle",
+                "summary": "Update a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Category ID",
+                        "name": "category_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Güncellenecek post bilgisi",
-                        "name": "post",
+                        "description": "Category information",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/requests.UpdatePostRequest"
+                            "$ref": "#/definitions/requests.UpdateCategoryRequest"
                         }
                     }
                 ],
@@ -721,29 +687,35 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.PostResponse"
+                            "$ref": "#/definitions/responses.CategoryResponse"
                         }
                     },
                     "400": {
-                        "description": "Geçersiz veri",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "Yetkisiz erişim",
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Post bulunamadı",
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug is already in use",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Sunucu hatası",
+                        "description": "Could not update category",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -751,50 +723,50 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "ID ile mevcut bir postu siler",
+                "description": "Delete a category. Its posts stay published but lose this category, and its child categories move up to the deleted category's parent. Requires the Editor or Admin role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Post"
+                    "Categories"
                 ],
-                "summary": "Mevcut bir postu sil",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Category ID",
+                        "name": "category_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Silme işlemi başarılı",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/responses.MessageResponse"
                         }
                     },
                     "400": {
-                        "description": "Geçersiz veri",
+                        "description": "Invalid category ID",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "Yetkisiz erişim",
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Post bulunamadı",
+                        "description": "Category not found",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Sunucu hatası",
+                        "description": "Could not delete category",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -802,45 +774,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/reactions": {
-            "post": {
-                "description": "Bir post veya yoruma reaction (like veya dislike) ekler",
-                "consumes": [
-                    "application/json"
-                ],
+        "/category/{category_id}/atom.xml": {
+            "get": {
+                "description": "Son yayımlanmış postları Atom 1.0 olarak döner; her girdi postun güncellenme zamanını taşır. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
                 "produces": [
-                    "application/json"
+                    "text/xml"
                 ],
                 "tags": [
-                    "Reaction"
+                    "Feed"
                 ],
-                "summary": "Yeni bir reaction ekle",
+                "summary": "Atom 1.0 beslemesi",
                 "parameters": [
                     {
-                        "description": "Reaction bilgisi",
-                        "name": "reaction",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/requests.CreateReactionRequest"
-                        }
+                        "type": "string",
+                        "description": "Kategori ID veya slug",
+                        "name": "category_id",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Atom belgesi",
                         "schema": {
-                            "$ref": "#/definitions/responses.ReactionResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Geçersiz veri",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
-                        }
+                    "304": {
+                        "description": "Besleme değişmedi"
                     },
-                    "401": {
-                        "description": "Yetkisiz",
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -854,34 +829,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/reactions/comment/{comment_id}": {
+        "/category/{category_id}/feed.json": {
             "get": {
-                "description": "Belirli bir yoruma ait reaction'ları (like/dislike) listele",
+                "description": "Son yayımlanmış postları JSON Feed 1.1 olarak döner. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reaction"
+                    "Feed"
                 ],
-                "summary": "Belirli bir yoruma ait tüm reaction'ları getir",
+                "summary": "JSON Feed 1.1 beslemesi",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Comment ID",
-                        "name": "comment_id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Kategori ID veya slug",
+                        "name": "category_id",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "JSON Feed belgesi",
                         "schema": {
-                            "$ref": "#/definitions/responses.ReactionsResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Geçersiz veri",
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -895,34 +884,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/reactions/post/{post_id}": {
+        "/category/{category_id}/feed.xml": {
             "get": {
-                "description": "Belirli bir posta ait reaction'ları (like/dislike) listele",
+                "description": "Son yayımlanmış postları RSS 2.0 olarak döner. Blogun tamamı, bir yazar, bir kategori (ID veya slug) veya bir etiket için besleme alınabilir. İçerik temizlenmiş HTML'dir. ETag ve Last-Modified ile koşullu istekleri destekler; yanıtlar kısa süre önbelleğe alınır.",
                 "produces": [
-                    "application/json"
+                    "text/xml"
                 ],
                 "tags": [
-                    "Reaction"
+                    "Feed"
                 ],
-                "summary": "Belirli bir posta ait tüm reaction'ları getir",
+                "summary": "RSS 2.0 beslemesi",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Kategori ID veya slug",
+                        "name": "category_id",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "RSS belgesi",
                         "schema": {
-                            "$ref": "#/definitions/responses.ReactionsResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Geçersiz veri",
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -936,52 +939,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/reactions/{reaction_id}": {
-            "delete": {
-                "description": "Belirli bir reaction'ı (like veya dislike) siler",
+        "/category/{category_id}/merge": {
+            "post": {
+                "description": "Move every post and child category of the source category to the target category, then delete the source. Requires the Editor or Admin role.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reaction"
+                    "Categories"
                 ],
-                "summary": "Bir reaction'ı sil",
+                "summary": "Merge a category into another one",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Reaction ID",
-                        "name": "reaction_id",
+                        "description": "Source category ID",
+                        "name": "category_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Target category",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MergeCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Reaction silindi",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.MessageResponse"
+                            "$ref": "#/definitions/responses.CategoryMergeResponse"
                         }
                     },
                     "400": {
-                        "description": "Geçersiz veri",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Yetkisiz",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Erişim reddedildi",
+                    "404": {
+                        "description": "Category not found",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Sunucu hatası",
+                        "description": "Could not merge categories",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -989,45 +1004,82 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/login": {
-            "post": {
-                "description": "Kullanıcı email ve şifre ile giriş yapar",
-                "consumes": [
-                    "application/json"
-                ],
+        "/category/{category_id}/posts": {
+            "get": {
+                "description": "Get a paginated list of posts assigned to a category; accepts the same filters and sort options as GET /posts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Categories"
                 ],
-                "summary": "Kullanıcı girişi",
+                "summary": "Retrieve posts in a category",
                 "parameters": [
                     {
-                        "description": "Giriş bilgileri",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/requests.UserLoginRequest"
-                        }
+                        "type": "string",
+                        "description": "Category ID or slug",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also include posts of child categories",
+                        "name": "include_descendants",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "meta.next_cursor from the previous response",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest",
+                            "most_reacted",
+                            "most_commented"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.LoginResponse"
+                            "$ref": "#/definitions/responses.PostsResponse"
                         }
                     },
                     "400": {
-                        "description": "Geçersiz veri",
+                        "description": "Invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Yetkisiz",
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Could not retrieve posts",
                         "schema": {
                             "$ref": "#/definitions/responses.ErrorResponse"
                         }
@@ -1035,9 +1087,152 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/register": {
-            "post": {
-                "description": "Yeni bir kullanıcı kaydı oluşturur",
+        "/comments/post/{post_id}": {
+            "get": {
+                "description": "Post ID'ye göre üst düzey yorumları (thread) sayfalı olarak getirir. Her yorumun altında en eski yanıtlar iç içe gömülü gelir; replies parametresi her seviyede kaç yanıt gömüleceğini belirler. Gömülmeyen yanıtlar has_more_replies ile belirtilir ve GET /comments/{comment_id}/replies ile alınır.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Belirli bir posta ait yorumları getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına thread (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest"
+                        ],
+                        "type": "string",
+                        "description": "Thread sıralaması (varsayılan oldest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Her yorumun altına gömülecek yanıt sayısı (varsayılan 3, en fazla 20)",
+                        "name": "replies",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/user": {
+            "get": {
+                "description": "Giriş yapmış kullanıcıya ait yorumları sayfalı olarak getirir",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Kullanıcıya ait yorumları getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest"
+                        ],
+                        "type": "string",
+                        "description": "Sıralama (varsayılan newest)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{comment_id}": {
+            "put": {
+                "description": "Belirli bir yorumu günceller. İstemci gördüğü sürümü If-Match başlığıyla (veya version alanıyla) göndermelidir; yorum bu arada değiştiyse 412 ile güncel hali döner.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1045,237 +1240,5320 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Comment"
                 ],
-                "summary": "Yeni kullanıcı kaydı",
+                "summary": "Mevcut bir yorumu güncelle",
                 "parameters": [
                     {
-                        "description": "Kayıt bilgileri",
-                        "name": "user",
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Yorumun ETag değeri, ör. \\",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Yorum bilgisi",
+                        "name": "comment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/requests.UserRegisterRequest"
+                            "$ref": "#/definitions/requests.UpdateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Yorumun yeni sürümü"
+                            }
+                        }
+                    },
+                    "202": {
+                        "description": "Düzenleme spam şüphesiyle yeniden onaya gönderildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Erişim reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Yorum başka biri tarafından değiştirildi; güncel hali döner",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Düzenleme spam olarak reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match veya version gerekli",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Belirli bir yorumu siler. Yanıtları olan bir yorum silindiğinde tartışma kopmasın diye yorum \"[deleted]\" yer tutucusu olarak kalır.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Mevcut bir yorumu sil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Yorum başarıyla silindi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Erişim reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
                         }
                     }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/responses.RegisterResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Geçersiz veri",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Sunucu hatası",
-                        "schema": {
-                            "$ref": "#/definitions/responses.ErrorResponse"
-                        }
+                }
+            }
+        },
+        "/comments/{comment_id}/replies": {
+            "get": {
+                "description": "Yorumun doğrudan yanıtlarını en eskiden başlayarak sayfalı olarak getirir (\"daha fazla yanıt yükle\"). Her yanıtın altında kendi yanıtları GetCommentsByPost'taki gibi gömülü gelir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Bir yorumun yanıtlarını getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına yanıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest"
+                        ],
+                        "type": "string",
+                        "description": "Sıralama (varsayılan oldest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Her yanıtın altına gömülecek yanıt sayısı (varsayılan 3, en fazla 20)",
+                        "name": "replies",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{comment_id}/reply": {
+            "post": {
+                "description": "Yorumun altına iç içe bir yanıt ekler. Yanıtlar en fazla COMMENT_MAX_DEPTH seviye (varsayılan 5) iç içe olabilir; daha derine yanıt verilemez.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Bir yoruma yanıt ver",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Yanıt verilen yorumun ID'si",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Yanıt bilgisi",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Yorum onay bekliyor",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri veya en fazla derinliğe ulaşıldı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yorumlar kapalı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı, silinmiş veya post yayımlanmamış",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Yorum spam olarak reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{comment_id}/revisions": {
+            "get": {
+                "description": "Yorumun kaydedilmiş tüm sürümlerini yeniden eskiye sayfalı olarak listeler. Yalnızca yorumun yazarı, Editor ve Admin görebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Yorumun sürümlerini listele",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentRevisionsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Erişim reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{comment_id}/revisions/diff": {
+            "get": {
+                "description": "İçerik farkını satır veya kelime düzeyinde döner. to verilmezse en son sürüm, from verilmezse ondan bir önceki sürüm kullanılır.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "İki yorum sürümü arasındaki farkı getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Eski sürüm numarası",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Yeni sürüm numarası",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "line",
+                            "word"
+                        ],
+                        "type": "string",
+                        "description": "Fark düzeyi (varsayılan word)",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.RevisionDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Erişim reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum veya sürüm bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{comment_id}/revisions/{revision}/restore": {
+            "post": {
+                "description": "Yorumun içeriğini verilen sürümdeki haline getirir ve bunu yeni bir sürüm olarak kaydeder. Yorumun yazarı, Editor ve Admin yapabilir. Moderatör olmayanların geri yüklemeleri düzenlemeler gibi spam kontrolünden geçer; şüpheli içerik onaylanmış yorumu yeniden onaya gönderir (202).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Yorumu eski bir sürüme geri döndür",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Geri dönülecek sürüm numarası",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Geri yüklenen içerik spam şüphesiyle yeniden onaya gönderildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Erişim reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum veya sürüm bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Yorum zaten bu sürümle aynı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Geri yüklenen içerik spam olarak reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{post_id}": {
+            "post": {
+                "description": "Belirli bir posta yeni bir yorum ekler",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Yeni bir yorum oluştur",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Yorum bilgisi",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Yorum onay bekliyor",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yorumlar kapalı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı veya yayımlanmamış",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Yorum spam olarak reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.json": {
+            "get": {
+                "description": "Son yayımlanmış postları JSON Feed 1.1 olarak döner. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "JSON Feed 1.1 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON Feed belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed.xml": {
+            "get": {
+                "description": "Son yayımlanmış postları RSS 2.0 olarak döner. Blogun tamamı, bir yazar, bir kategori (ID veya slug) veya bir etiket için besleme alınabilir. İçerik temizlenmiş HTML'dir. ETag ve Last-Modified ile koşullu istekleri destekler; yanıtlar kısa süre önbelleğe alınır.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "RSS 2.0 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/forms/token": {
+            "get": {
+                "description": "Kayıt veya yorum formu açılırken çağrılır. Dönen token gönderimde form_token alanıyla geri yollanır; token gönderilmezse, geçersizse veya form SPAM_MIN_SUBMIT_TIME süresinden hızlı gönderilirse spam puanı artar. Token isteği yapan IP adresine bağlıdır, 24 saat geçerlidir ve ilk kullanımından sonra 10 dakika boyunca tekrar gönderilebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spam"
+                ],
+                "summary": "Form token'ı al",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.FormTokenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderation/comments": {
+            "get": {
+                "description": "Onay bekleyen yorumları eskiden yeniye sayfalı olarak listeler. Editor ve Admin tüm yorumları, diğer kullanıcılar yalnızca kendi postlarındaki yorumları görür. status ile onaylanmış veya reddedilmiş yorumlar, post_id ile tek bir postun yorumları listelenebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Moderasyon kuyruğunu getir",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "description": "Yorum durumu (varsayılan pending)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest"
+                        ],
+                        "type": "string",
+                        "description": "Sıralama (varsayılan oldest)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderation/comments/bulk": {
+            "post": {
+                "description": "Aynı kararı en fazla 100 yoruma uygular. Yorumlar ID sırasıyla işlenir, böylece bir yanıt üst yorumuyla birlikte onaylanabilir. Karar verilemeyen yorumlar nedenleriyle skipped içinde döner; diğerleri etkilenmez.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Yorumları toplu olarak onayla veya reddet",
+                "parameters": [
+                    {
+                        "description": "Yorumlar, karar ve neden",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkModerateCommentsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkModerationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri veya eksik neden",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderation/comments/{comment_id}/approve": {
+            "post": {
+                "description": "Bekleyen veya reddedilmiş yorumu yayımlar. Editor, Admin ve postun yazarı onaylayabilir. Yanıtlar, üst yorumları onaylanmadan onaylanamaz.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Yorumu onayla",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Karar nedeni",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/requests.ModerateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Moderasyon yetkisi yok",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Yorum zaten onaylanmış veya üst yorum onaylanmamış",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderation/comments/{comment_id}/history": {
+            "get": {
+                "description": "Yorum hakkında verilen otomatik ve elle alınmış tüm kararları, karar verenle ve nedeniyle eskiden yeniye listeler. Otomatik kararların actor_id değeri sıfır UUID'dir. Yorumun yazarı, postun yazarı, Editor ve Admin görebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Yorumun moderasyon geçmişini getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CommentModerationResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Erişim reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderation/comments/{comment_id}/reject": {
+            "post": {
+                "description": "Yorumu gizler ve arama indeksinden çıkarır; neden zorunludur. Editor, Admin ve postun yazarı reddedebilir. Onaylanmış bir yorum reddedilirse yanıtları da gizlenir.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Yorumu reddet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ret nedeni",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.ModerateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri veya eksik neden",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Moderasyon yetkisi yok",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Yorum bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Yorum zaten reddedilmiş",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts": {
+            "get": {
+                "description": "Yayımlanmış postları sayfalı olarak listeler; Editor ve Admin status ile diğer durumları (örn. inceleme kuyruğu için in_review) listeleyebilir. page/limit veya önceki yanıttaki next_cursor ile cursor tabanlı sayfalama desteklenir; yazar, kategori ve tarih aralığına göre filtrelenip sıralanabilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Postları listele",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Yazarın kullanıcı adı",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Yazarın ID'si",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Kategori ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Etiket slug'ı",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bu tarihten sonra oluşturulanlar (RFC3339 veya YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bu tarihten önce oluşturulanlar (RFC3339 veya YYYY-MM-DD, gün dahil)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest",
+                            "most_reacted",
+                            "most_commented"
+                        ],
+                        "type": "string",
+                        "description": "Sıralama",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "draft",
+                            "in_review",
+                            "scheduled",
+                            "published",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "Durum (varsayılan published)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yayımlanmamış postları yalnızca editörler listeleyebilir",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Yeni bir post oluşturur; category_ids ile kategorilere bağlanabilir. slug verilmezse başlıktan üretilir. Postlar taslak olarak oluşturulur; status=in_review doğrudan incelemeye gönderir (yazarlar için Author rolü gerekir), status=published yalnızca Editor ve Admin için geçerlidir. comment_mode (open, moderated, closed) postun yorum ayarını belirler; verilmezse sitenin ayarı geçerlidir.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Yeni bir post oluştur",
+                "parameters": [
+                    {
+                        "description": "Post bilgisi",
+                        "name": "post",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreatePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri veya bilinmeyen kategori",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Bu durumla oluşturma yetkisi yok",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug başka bir post tarafından kullanılıyor",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/by-slug/{slug}": {
+            "get": {
+                "description": "Postu URL slug'ı ile getirir. Slug postun eski bir slug'ı ise güncel adrese 301 ile yönlendirir. Yayımlanmamış postları yalnızca yazarı, Editor ve Admin görebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Postu slug ile getir",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag; post değişmediyse 304 döner",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Postun sürümü"
+                            }
+                        }
+                    },
+                    "301": {
+                        "description": "Eski slug; Location başlığı güncel adresi verir"
+                    },
+                    "304": {
+                        "description": "Post değişmedi"
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/mine": {
+            "get": {
+                "description": "Giriş yapmış kullanıcının tüm durumlardaki postlarını listeler; status=draft ile taslaklar getirilir. GET /posts ile aynı sayfalama, filtre ve sıralama parametrelerini kabul eder.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Kendi postlarımı listele",
+                "parameters": [
+                    {
+                        "enum": [
+                            "draft",
+                            "in_review",
+                            "scheduled",
+                            "published",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "Durum",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest",
+                            "most_reacted",
+                            "most_commented"
+                        ],
+                        "type": "string",
+                        "description": "Sıralama",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}": {
+            "get": {
+                "description": "ID ile tek bir postu getirir. Yayımlanmamış postları yalnızca yazarı, Editor ve Admin görebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Belirli bir postu getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag; post değişmediyse 304 döner",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Postun sürümü"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Post değişmedi"
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "ID ile mevcut bir postu günceller; yazar dışında Editor ve Admin de güncelleyebilir. category_ids gönderilirse postun kategorileri bu listeyle değiştirilir. slug değiştirilirse eski slug yeni adrese yönlendirilir. comment_mode yorumları açar, onaya bağlar veya kapatır; boş değer sitenin ayarına döner. İstemci gördüğü sürümü If-Match başlığıyla (veya version alanıyla) göndermelidir; post bu arada değiştiyse 412 ile güncel hali döner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Mevcut bir postu güncelle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "GetPost yanıtındaki ETag, ör. \\",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Güncellenecek post bilgisi",
+                        "name": "post",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdatePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Postun yeni sürümü"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri veya bilinmeyen kategori",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yetkisiz erişim",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug başka bir post tarafından kullanılıyor",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Post başka biri tarafından değiştirildi; güncel hali döner",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match veya version gerekli",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "ID ile mevcut bir postu siler; yazar veya Admin silebilir",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Mevcut bir postu sil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Silme işlemi başarılı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yetkisiz erişim",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/revisions": {
+            "get": {
+                "description": "Postun kaydedilmiş tüm sürümlerini yeniden eskiye sayfalı olarak listeler. Yalnızca yazar, Editor ve Admin görebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Postun sürümlerini listele",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostRevisionsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yetkisiz erişim",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/revisions/diff": {
+            "get": {
+                "description": "Başlık ve içerik farkını satır veya kelime düzeyinde döner. to verilmezse en son sürüm, from verilmezse ondan bir önceki sürüm kullanılır.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "İki post sürümü arasındaki farkı getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Eski sürüm numarası",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Yeni sürüm numarası",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "line",
+                            "word"
+                        ],
+                        "type": "string",
+                        "description": "Fark düzeyi (varsayılan line)",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.RevisionDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yetkisiz erişim",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post veya sürüm bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/revisions/{revision}": {
+            "get": {
+                "description": "Postun numarası verilen sürümünü getirir. Yalnızca yazar, Editor ve Admin görebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Postun belirli bir sürümünü getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sürüm numarası",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostRevisionResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yetkisiz erişim",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post veya sürüm bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/revisions/{revision}/restore": {
+            "post": {
+                "description": "Postun başlık ve içeriğini verilen sürümdeki haline getirir ve bunu yeni bir sürüm olarak kaydeder; geçmiş silinmez. Yazar, Editor ve Admin yapabilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Postu eski bir sürüme geri döndür",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Geri dönülecek sürüm numarası",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Yetkisiz erişim",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post veya sürüm bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Post zaten bu sürümle aynı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/status": {
+            "post": {
+                "description": "Postu yayın akışında ilerletir. Author rolündeki yazar taslağı incelemeye gönderir, yazar geri çeker veya arşivler; Editor onaylar, not ile reddeder, publish_at ile zamanlar veya yayından kaldırır; Admin her geçişi yapabilir. unpublish_at verilirse post o zaman arşivlenir; verilmezse postun mevcut unpublish_at değeri korunur, clear_unpublish_at ile silinir.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Postun durumunu değiştir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Yeni durum ve not",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.ChangePostStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz durum veya eksik not",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Geçiş için yetki yok",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Geçiş bu durumdan yapılamaz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/status-history": {
+            "get": {
+                "description": "Postun yayın akışındaki tüm durum değişikliklerini ve editör notlarını eskiden yeniye listeler. Yalnızca yazar, Editor ve Admin görebilir.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Post"
+                ],
+                "summary": "Postun durum geçmişini getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.PostStatusChangeResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Yetkisiz erişim",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reactions": {
+            "post": {
+                "description": "Bir post veya yoruma reaction (like veya dislike) ekler",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reaction"
+                ],
+                "summary": "Yeni bir reaction ekle",
+                "parameters": [
+                    {
+                        "description": "Reaction bilgisi",
+                        "name": "reaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateReactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ReactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post bulunamadı veya yayımlanmamış",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reactions/comment/{comment_id}": {
+            "get": {
+                "description": "Belirli bir yoruma ait reaction'ları (like/dislike) listele",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reaction"
+                ],
+                "summary": "Belirli bir yoruma ait tüm reaction'ları getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest"
+                        ],
+                        "type": "string",
+                        "description": "Sıralama (varsayılan newest)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ReactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reactions/post/{post_id}": {
+            "get": {
+                "description": "Belirli bir posta ait reaction'ları (like/dislike) listele",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reaction"
+                ],
+                "summary": "Belirli bir posta ait tüm reaction'ları getir",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki meta.next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest"
+                        ],
+                        "type": "string",
+                        "description": "Sıralama (varsayılan newest)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ReactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reactions/{reaction_id}": {
+            "delete": {
+                "description": "Belirli bir reaction'ı (like veya dislike) siler",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reaction"
+                ],
+                "summary": "Bir reaction'ı sil",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reaction ID",
+                        "name": "reaction_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reaction silindi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Erişim reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Yayımlanmış postların başlık ve içeriğinde, bunlara yazılmış yorumlarda tam metin arama yapar. Sonuçlar alaka puanına göre sıralanır; başlıkta geçen kelimeler daha yüksek puan alır. Her kelime önek olarak eşleşir ve tüm kelimeler bulunmalıdır; büyük/küçük harf ve Türkçe karakterler (ı, ş, ğ…) ayırt edilmez. snippet alanı eşleşmeleri \u003cmark\u003e ile işaretlenmiş HTML'dir. Cursor ile sayfalama desteklenmez.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Postlarda ve yorumlarda ara",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Aranacak kelimeler (en fazla 200 karakter)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "post",
+                            "comment"
+                        ],
+                        "type": "string",
+                        "description": "Sonuç türü (varsayılan all)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Yazarın kullanıcı adı",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Yazarın ID'si",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Postun kategori ID'si",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bu tarihten sonra oluşturulanlar (RFC3339 veya YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bu tarihten önce oluşturulanlar (RFC3339 veya YYYY-MM-DD, gün dahil)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa numarası (varsayılan 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sayfa başına kayıt (varsayılan 20, en fazla 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz parametre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Get a paginated list of tags used by at least one published post, with the number of such posts. Sorted by usage by default, which makes the list usable as a tag cloud.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Retrieve tags",
+                "parameters": [
+                    {
+                        "enum": [
+                            "popular",
+                            "name"
+                        ],
+                        "type": "string",
+                        "description": "Sort order (default popular)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "meta.next_cursor from the previous response",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.TagUsageResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Could not retrieve tags",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}": {
+            "put": {
+                "description": "Change a tag's name and slug. Omitting slug derives it from the new name. If another tag already has the slug, the request is rejected; merge the tags instead. Requires the Editor or Admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Rename a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag information",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug is already in use",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Could not update tag",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/atom.xml": {
+            "get": {
+                "description": "Son yayımlanmış postları Atom 1.0 olarak döner; her girdi postun güncellenme zamanını taşır. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "Atom 1.0 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Etiket slug'ı",
+                        "name": "slug",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/feed.json": {
+            "get": {
+                "description": "Son yayımlanmış postları JSON Feed 1.1 olarak döner. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "JSON Feed 1.1 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Etiket slug'ı",
+                        "name": "slug",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON Feed belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/feed.xml": {
+            "get": {
+                "description": "Son yayımlanmış postları RSS 2.0 olarak döner. Blogun tamamı, bir yazar, bir kategori (ID veya slug) veya bir etiket için besleme alınabilir. İçerik temizlenmiş HTML'dir. ETag ve Last-Modified ile koşullu istekleri destekler; yanıtlar kısa süre önbelleğe alınır.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "RSS 2.0 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Etiket slug'ı",
+                        "name": "slug",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/merge": {
+            "post": {
+                "description": "Move the tag from every post that carries it to the target tag, then delete it. Requires the Editor or Admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Merge a tag into another one",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target tag",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MergeTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.TagMergeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Could not merge tags",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/posts": {
+            "get": {
+                "description": "Get a paginated list of posts carrying a tag; accepts the same filters and sort options as GET /posts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Retrieve posts with a tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "meta.next_cursor from the previous response",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "oldest",
+                            "most_reacted",
+                            "most_commented"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Could not retrieve posts",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Kullanıcı email ve şifre ile giriş yapar. İki adımlı doğrulama etkinse token yerine mfa_token döner ve giriş /users/login/mfa ile tamamlanır. Tekrarlanan hatalı denemelerde artan bekleme süresi ve geçici kilitlenme uygulanır.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Kullanıcı girişi",
+                "parameters": [
+                    {
+                        "description": "Giriş bilgileri",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UserLoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Geçersiz email veya şifre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Çok fazla hatalı deneme, Retry-After başlığındaki süre kadar bekleyin",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login/mfa": {
+            "post": {
+                "description": "Giriş adımında dönen mfa_token ile TOTP veya kurtarma kodunu doğrular ve oturum token'larını döner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "İki adımlı girişi tamamla",
+                "parameters": [
+                    {
+                        "description": "MFA token ve kod",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MFALoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Geçersiz token veya kod",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Çok fazla hatalı deneme",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "description": "Mevcut oturumu ve ona ait refresh token ailesini iptal eder",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Oturumu kapat",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout/all": {
+            "post": {
+                "description": "Kullanıcının tüm cihazlardaki oturumlarını iptal eder",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Tüm oturumları kapat",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "description": "Giriş yapmış kullanıcının profil bilgilerini ve rollerini döner",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Kendi profilini getir",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ProfileResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Hesabı bekleme süresinin sonunda silinmek üzere işaretler, tüm oturumları ve API token'larını iptal eder. mode=anonymize yazı ve yorumları \"deleted\" kullanıcısına devreder, mode=delete bunları kalıcı olarak siler. Bekleme süresi içinde tekrar giriş yapılarak talep iptal edilebilir.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Hesabı sil",
+                "parameters": [
+                    {
+                        "description": "Şifre ve silme modu",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.DeleteAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/responses.AccountDeletionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz veya şifre hatalı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Silme talebi zaten var",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Ad, soyad, kullanıcı adı, biyografi ve web sitesi alanlarından gönderilenleri günceller",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Profili güncelle",
+                "parameters": [
+                    {
+                        "description": "Güncellenecek alanlar",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Kullanıcı adı kullanımda",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/avatar": {
+            "post": {
+                "description": "PNG, JPEG, GIF veya WebP biçiminde en fazla 2 MB'lık bir profil fotoğrafı yükler; önceki fotoğraf silinir",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Profil fotoğrafı yükle",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Profil fotoğrafı",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz dosya",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Dosya çok büyük",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/deletion/cancel": {
+            "post": {
+                "description": "Bekleme süresi dolmamış hesap silme talebini geri alır",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Hesap silme talebini iptal et",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Silme talebi yok",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/export": {
+            "get": {
+                "description": "Profil, yazılar, yorumlar, tepkiler ve oturum geçmişini içeren bir arşiv döner. format=zip (varsayılan) her bölüm için ayrı JSON dosyası içeren bir ZIP, format=json tek bir JSON belgesi üretir.",
+                "produces": [
+                    "application/json",
+                    "application/zip"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Kişisel verileri dışa aktar",
+                "parameters": [
+                    {
+                        "enum": [
+                            "zip",
+                            "json"
+                        ],
+                        "type": "string",
+                        "description": "zip veya json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.DataExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz format",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/mfa/recovery-codes": {
+            "post": {
+                "description": "Geçerli bir TOTP kodu ile eski kurtarma kodlarını geçersiz kılar ve yenilerini üretir",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MFA"
+                ],
+                "summary": "Kurtarma kodlarını yenile",
+                "parameters": [
+                    {
+                        "description": "TOTP kodu",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MFACodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.RecoveryCodesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz kod",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/mfa/totp": {
+            "delete": {
+                "description": "Şifre ve TOTP/kurtarma kodu ile iki adımlı doğrulamayı kapatır; şifresi olmayan (yalnızca OIDC) hesaplarda kod yeterlidir. Rolü 2FA gerektiren kullanıcılar kapatamaz.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MFA"
+                ],
+                "summary": "TOTP'yi devre dışı bırak",
+                "parameters": [
+                    {
+                        "description": "Şifre ve kod",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.DisableMFARequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz kod veya şifre",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Rol 2FA gerektiriyor",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/mfa/totp/confirm": {
+            "post": {
+                "description": "Authenticator uygulamasından alınan kod ile TOTP'yi etkinleştirir ve bir kez gösterilecek kurtarma kodlarını döner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MFA"
+                ],
+                "summary": "TOTP kurulumunu onayla",
+                "parameters": [
+                    {
+                        "description": "TOTP kodu",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MFACodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.RecoveryCodesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz kod",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "TOTP zaten etkin",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/mfa/totp/setup": {
+            "post": {
+                "description": "Yeni bir TOTP anahtarı üretir; authenticator uygulamasına eklemek için anahtarı, otpauth adresini ve QR kodunu döner. Kurulum /users/me/mfa/totp/confirm ile tamamlanana kadar giriş akışını etkilemez.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MFA"
+                ],
+                "summary": "TOTP kurulumunu başlat",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.TOTPSetupResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "TOTP zaten etkin",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/password": {
+            "post": {
+                "description": "Mevcut şifre doğrulandıktan sonra yeni şifreyi kaydeder ve mevcut oturum dışındaki tüm oturumları kapatır",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Şifre değiştir",
+                "parameters": [
+                    {
+                        "description": "Mevcut ve yeni şifre",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz veya mevcut şifre hatalı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/tokens": {
+            "get": {
+                "description": "Giriş yapmış kullanıcının token'larını son kullanım zamanlarıyla birlikte listeler; token değerleri gösterilmez",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tokens"
+                ],
+                "summary": "Personal access token'ları listele",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.PersonalAccessTokenResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "CI ve betikler için kapsamı sınırlı bir API token'ı oluşturur. Token yalnızca bu yanıtta gösterilir; sunucuda özeti saklanır. Kullanılabilir kapsamlar: posts, comments, reactions, categories ve user kaynakları için :read ve :write.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tokens"
+                ],
+                "summary": "Personal access token oluştur",
+                "parameters": [
+                    {
+                        "description": "Token bilgileri",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreatePersonalAccessTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CreatedPersonalAccessTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz istek veya kapsam",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/tokens/{token_id}": {
+            "delete": {
+                "description": "Token'ı kalıcı olarak geçersiz kılar; kayıt son kullanım bilgisiyle birlikte listede kalır",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tokens"
+                ],
+                "summary": "Personal access token'ı iptal et",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Token ID",
+                        "name": "token_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token iptal edildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Token bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password/forgot": {
+            "post": {
+                "description": "Email kayıtlıysa tek kullanımlık, süreli bir şifre sıfırlama bağlantısı gönderir. Hangi adreslerin kayıtlı olduğunu sızdırmamak için yanıt her durumda aynıdır.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Şifre sıfırlama bağlantısı iste",
+                "parameters": [
+                    {
+                        "description": "Email adresi",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password/reset": {
+            "post": {
+                "description": "Şifre sıfırlama token'ı ile yeni şifre belirler ve kullanıcının tüm oturumlarını kapatır",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Şifreyi sıfırla",
+                "parameters": [
+                    {
+                        "description": "Token ve yeni şifre",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veya süresi dolmuş token",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/refresh": {
+            "post": {
+                "description": "Refresh token'ı tek kullanımlık olarak yeni bir access/refresh token çiftiyle değiştirir. Daha önce kullanılmış bir token gönderilirse oturumun tamamı iptal edilir.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Access token yenile",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Geçersiz veya iptal edilmiş token",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/register": {
+            "post": {
+                "description": "Yeni bir kullanıcı kaydı oluşturur",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Yeni kullanıcı kaydı",
+                "parameters": [
+                    {
+                        "description": "Kayıt bilgileri",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UserRegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.RegisterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veri",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Kullanıcı adı veya email kullanımda",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Kayıt spam olarak reddedildi",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/verify": {
+            "get": {
+                "description": "Kayıt sonrası gönderilen doğrulama token'ı ile kullanıcının email adresini doğrular",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Email adresini doğrula",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Doğrulama token'ı",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Geçersiz veya süresi dolmuş token",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/verify/resend": {
+            "post": {
+                "description": "Giriş yapmış ve email adresi henüz doğrulanmamış kullanıcıya yeni bir doğrulama bağlantısı gönderir",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Doğrulama emailini tekrar gönder",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Yetkisiz",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email zaten doğrulanmış",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}": {
+            "get": {
+                "description": "Kullanıcı adına göre herkese açık profil bilgilerini, yazı ve yorum sayılarını ve son yazılarını döner",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Yazar profilini getir",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kullanıcı adı",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PublicProfileResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Kullanıcı bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/atom.xml": {
+            "get": {
+                "description": "Son yayımlanmış postları Atom 1.0 olarak döner; her girdi postun güncellenme zamanını taşır. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "Atom 1.0 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Yazarın kullanıcı adı",
+                        "name": "username",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/feed.json": {
+            "get": {
+                "description": "Son yayımlanmış postları JSON Feed 1.1 olarak döner. Kapsam ve önbellek davranışı RSS beslemesiyle aynıdır.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "JSON Feed 1.1 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Yazarın kullanıcı adı",
+                        "name": "username",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON Feed belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/feed.xml": {
+            "get": {
+                "description": "Son yayımlanmış postları RSS 2.0 olarak döner. Blogun tamamı, bir yazar, bir kategori (ID veya slug) veya bir etiket için besleme alınabilir. İçerik temizlenmiş HTML'dir. ETag ve Last-Modified ile koşullu istekleri destekler; yanıtlar kısa süre önbelleğe alınır.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "Feed"
+                ],
+                "summary": "RSS 2.0 beslemesi",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Yazarın kullanıcı adı",
+                        "name": "username",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki ETag",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Önceki yanıttaki Last-Modified",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "RSS belgesi",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Besleme değişmedi"
+                    },
+                    "404": {
+                        "description": "Yazar, kategori veya etiket bulunamadı",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Sunucu hatası",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "requests.AddRoleRequest": {
+            "type": "object",
+            "required": [
+                "role_id",
+                "user_id"
+            ],
+            "properties": {
+                "role_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.BulkModerateCommentsRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "comment_ids"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "approve",
+                        "reject"
+                    ]
+                },
+                "comment_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "requests.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "requests.ChangePostStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "clear_unpublish_at": {
+                    "description": "Mevcut unpublish_at değerini siler",
+                    "type": "boolean"
+                },
+                "note": {
+                    "description": "Editör reddederken veya yayından kaldırırken zorunludur",
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "publish_at": {
+                    "description": "status=scheduled için zorunlu; post bu zamanda yayına girer",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "unpublish_at": {
+                    "description": "Verilirse post bu zamanda arşivlenir; verilmezse mevcut değer korunur",
+                    "type": "string"
+                }
+            }
+        },
+        "requests.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 80
+                }
+            }
+        },
+        "requests.CreateCommentRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "content_format": {
+                    "description": "Varsayılan markdown",
+                    "type": "string",
+                    "enum": [
+                        "markdown",
+                        "plain",
+                        "html"
+                    ]
+                },
+                "form_token": {
+                    "description": "Form açılırken GET /forms/token ile alınan token",
+                    "type": "string"
+                },
+                "honeypot": {
+                    "description": "Formda gizlenen tuzak alanı; insanlar boş bırakır",
+                    "type": "string"
+                }
+            }
+        },
+        "requests.CreatePersonalAccessTokenRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_in_days": {
+                    "description": "Boş ise süresiz",
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "scopes": {
+                    "description": "ör. \"posts:write\", \"comments:read\"",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "requests.CreatePostRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "title"
+            ],
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "comment_mode": {
+                    "description": "Boşsa sitenin COMMENT_MODERATION ayarı geçerlidir",
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "moderated",
+                        "closed"
+                    ]
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 100000
+                },
+                "content_format": {
+                    "description": "Varsayılan markdown",
+                    "type": "string",
+                    "enum": [
+                        "markdown",
+                        "plain",
+                        "html"
+                    ]
+                },
+                "slug": {
+                    "description": "Verilmezse başlıktan üretilir",
+                    "type": "string",
+                    "maxLength": 80
+                },
+                "status": {
+                    "description": "Varsayılan draft; in_review doğrudan incelemeye gönderir",
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "in_review",
+                        "published"
+                    ]
+                },
+                "tags": {
+                    "description": "Bilinmeyen etiketler oluşturulur; büyük/küçük harf ve tekrarlar yok sayılır",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.CreateReactionRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "comment_id": {
+                    "description": "Sadece comment için",
+                    "type": "integer"
+                },
+                "post_id": {
+                    "description": "Sadece post için",
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "like",
+                        "dislike"
+                    ]
+                }
+            }
+        },
+        "requests.CreateRoleRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.DeleteAccountRequest": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "description": "Varsayılan: anonymize",
+                    "type": "string",
+                    "enum": [
+                        "anonymize",
+                        "delete"
+                    ]
+                },
+                "password": {
+                    "description": "Şifresi olmayan (yalnızca OIDC) hesaplarda gerekmez",
+                    "type": "string"
+                }
+            }
+        },
+        "requests.DisableMFARequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "TOTP veya kurtarma kodu",
+                    "type": "string"
+                },
+                "password": {
+                    "description": "Şifresi olmayan (yalnızca OIDC) hesaplarda gerekmez",
+                    "type": "string"
+                }
+            }
+        },
+        "requests.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.MFACodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.MFALoginRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "mfa_token"
+            ],
+            "properties": {
+                "code": {
+                    "description": "TOTP veya kurtarma kodu",
+                    "type": "string"
+                },
+                "mfa_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.MergeCategoryRequest": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "target_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "requests.MergeTagRequest": {
+            "type": "object",
+            "required": [
+                "target"
+            ],
+            "properties": {
+                "target": {
+                    "description": "Hedef etiketin slug'ı",
+                    "type": "string",
+                    "maxLength": 80
+                }
+            }
+        },
+        "requests.ModerateCommentRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Reddederken zorunludur; karar kaydında saklanır",
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "requests.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.RemoveRoleRequest": {
+            "type": "object",
+            "required": [
+                "role_id",
+                "user_id"
+            ],
+            "properties": {
+                "role_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.SetRoleMFARequest": {
+            "type": "object",
+            "required": [
+                "require_mfa"
+            ],
+            "properties": {
+                "require_mfa": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "requests.UpdateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 80
+                }
+            }
+        },
+        "requests.UpdateCommentRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "change_note": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "content_format": {
+                    "description": "Gönderilmezse mevcut biçim korunur",
+                    "type": "string",
+                    "enum": [
+                        "markdown",
+                        "plain",
+                        "html"
+                    ]
+                },
+                "version": {
+                    "description": "If-Match başlığı gönderilmezse zorunludur",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "requests.UpdatePostRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "title"
+            ],
+            "properties": {
+                "category_ids": {
+                    "description": "Gönderilmezse kategoriler değişmez, boş liste tüm kategorileri kaldırır",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "change_note": {
+                    "description": "Başlık veya içerik değişirse oluşturulan sürüme eklenir",
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "comment_mode": {
+                    "description": "Gönderilmezse değişmez, boş değer sitenin COMMENT_MODERATION ayarına döner",
+                    "type": "string",
+                    "enum": [
+                        "",
+                        "open",
+                        "moderated",
+                        "closed"
+                    ]
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 100000
+                },
+                "content_format": {
+                    "description": "Gönderilmezse mevcut biçim korunur",
+                    "type": "string",
+                    "enum": [
+                        "markdown",
+                        "plain",
+                        "html"
+                    ]
+                },
+                "slug": {
+                    "description": "Gönderilmezse slug değişmez, boş değer başlıktan yeniden üretir; eski slug yönlendirme olarak kalır",
+                    "type": "string",
+                    "maxLength": 80
+                },
+                "tags": {
+                    "description": "Gönderilmezse etiketler değişmez, boş liste tüm etiketleri kaldırır",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "If-Match başlığı gönderilmezse zorunludur; GetPost yanıtındaki version değeri",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "requests.UpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "first_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "last_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 3
+                },
+                "website": {
+                    "description": "Boş string alanı temizler",
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "requests.UpdateTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 80
+                }
+            }
+        },
+        "requests.UserLoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "requests.UserRegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "first_name",
+                "last_name",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "form_token": {
+                    "description": "Form açılırken GET /forms/token ile alınan token",
+                    "type": "string"
+                },
+                "honeypot": {
+                    "description": "Formda gizlenen tuzak alanı; insanlar boş bırakır",
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.AccountDeletionResponse": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "type": "string"
+                },
+                "scheduled_at": {
+                    "description": "Bu zamana kadar /users/me/deletion/cancel ile iptal edilebilir",
+                    "type": "string"
+                }
+            }
+        },
+        "responses.BulkModerationResponse": {
+            "type": "object",
+            "properties": {
+                "moderated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CommentResponse"
+                    }
+                },
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.BulkModerationSkip"
+                    }
+                }
+            }
+        },
+        "responses.BulkModerationSkip": {
+            "type": "object",
+            "properties": {
+                "comment_id": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.CategoryCrumb": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.CategoryMergeResponse": {
+            "type": "object",
+            "properties": {
+                "moved_posts": {
+                    "type": "integer"
+                },
+                "target": {
+                    "$ref": "#/definitions/responses.CategoryResponse"
+                }
+            }
+        },
+        "responses.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "breadcrumb": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CategoryCrumb"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.CategoryTreeNode": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CategoryTreeNode"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.CommentModerationResponse": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_format": {
+                    "type": "string"
+                },
+                "content_html": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted": {
+                    "type": "boolean"
+                },
+                "depth": {
+                    "type": "integer"
+                },
+                "has_more_replies": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "post_id": {
+                    "type": "integer"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CommentResponse"
+                    }
+                },
+                "reply_count": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.CommentRevisionResponse": {
+            "type": "object",
+            "properties": {
+                "change_note": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_format": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "editor_id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.CommentRevisionsResponse": {
+            "type": "object",
+            "properties": {
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CommentRevisionResponse"
+                    }
+                }
+            }
+        },
+        "responses.CommentsResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CommentResponse"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "requests.AddRoleRequest": {
+        },
+        "responses.CreatedPersonalAccessTokenResponse": {
             "type": "object",
-            "required": [
-                "role_id",
-                "user_id"
-            ],
             "properties": {
-                "role_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "user_id": {
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "token": {
+                    "description": "Yalnızca oluşturulurken bir kez gösterilir",
                     "type": "string"
                 }
             }
         },
-        "requests.CreateCategoryRequest": {
+        "responses.DataExportResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "name": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CommentResponse"
+                    }
+                },
+                "exported_at": {
                     "type": "string"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.PostResponse"
+                    }
+                },
+                "profile": {
+                    "$ref": "#/definitions/responses.ProfileResponse"
+                },
+                "reactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.ReactionResponse"
+                    }
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.SessionExport"
+                    }
                 }
             }
         },
-        "requests.CreateCommentRequest": {
+        "responses.DiffOp": {
             "type": "object",
-            "required": [
-                "content"
-            ],
             "properties": {
-                "content": {
+                "text": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "requests.CreatePostRequest": {
+        "responses.ErrorResponse": {
             "type": "object",
-            "required": [
-                "content",
-                "title"
-            ],
             "properties": {
-                "content": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.FormTokenResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
                     "type": "string"
                 },
-                "title": {
+                "token": {
+                    "description": "Kayıt ve yorum formlarında form_token olarak geri gönderilir",
                     "type": "string"
                 }
             }
         },
-        "requests.CreateReactionRequest": {
+        "responses.LoginResponse": {
             "type": "object",
-            "required": [
-                "type"
-            ],
             "properties": {
-                "comment_id": {
-                    "description": "Sadece comment için",
+                "expires_in": {
+                    "description": "Access token'ın saniye cinsinden ömrü",
                     "type": "integer"
                 },
-                "post_id": {
-                    "description": "Sadece post için",
-                    "type": "integer"
+                "message": {
+                    "type": "string"
                 },
-                "type": {
-                    "type": "string",
-                    "enum": [
-                        "like",
-                        "dislike"
-                    ]
+                "mfa_enrollment_required": {
+                    "description": "Rolü 2FA gerektiren ancak TOTP kurmamış kullanıcılar yalnızca kurulum uçlarına erişebilir",
+                    "type": "boolean"
+                },
+                "mfa_required": {
+                    "description": "İki adımlı doğrulama etkinse token alanları boş döner, giriş mfa_token ile tamamlanır",
+                    "type": "boolean"
+                },
+                "mfa_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
                 }
             }
         },
-        "requests.CreateRoleRequest": {
+        "responses.MessageResponse": {
             "type": "object",
-            "required": [
-                "description",
-                "name"
-            ],
             "properties": {
-                "description": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.PersonalAccessTokenResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_used_at": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "responses.PostResponse": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CategoryResponse"
+                    }
+                },
+                "comment_mode": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_format": {
+                    "type": "string"
+                },
+                "content_html": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.TagResponse"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "unpublish_at": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "requests.RemoveRoleRequest": {
+        "responses.PostRevisionResponse": {
             "type": "object",
-            "required": [
-                "role_id",
-                "user_id"
-            ],
             "properties": {
-                "role_id": {
+                "change_note": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "content_format": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "editor_id": {
+                    "type": "string"
+                },
+                "number": {
                     "type": "integer"
                 },
-                "user_id": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "requests.UpdateCommentRequest": {
+        "responses.PostRevisionsResponse": {
             "type": "object",
-            "required": [
-                "content"
-            ],
             "properties": {
-                "content": {
-                    "type": "string"
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.PostRevisionResponse"
+                    }
                 }
             }
         },
-        "requests.UpdatePostRequest": {
+        "responses.PostStatusChangeResponse": {
             "type": "object",
-            "required": [
-                "content",
-                "title"
-            ],
             "properties": {
-                "content": {
+                "actor_id": {
                     "type": "string"
                 },
-                "title": {
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "to_status": {
                     "type": "string"
                 }
             }
         },
-        "requests.UserLoginRequest": {
+        "responses.PostsResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string"
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.PostResponse"
+                    }
                 }
             }
         },
-        "requests.UserRegisterRequest": {
+        "responses.ProfileResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "first_name",
-                "last_name",
-                "password",
-                "username"
-            ],
             "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "deletion_mode": {
+                    "type": "string"
+                },
+                "deletion_scheduled_at": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
+                "email_verified": {
+                    "type": "boolean"
+                },
                 "first_name": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
                 "last_name": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "totp_enabled": {
+                    "type": "boolean"
                 },
                 "username": {
                     "type": "string"
+                },
+                "website": {
+                    "type": "string"
                 }
             }
         },
-        "responses.CategoryResponse": {
+        "responses.PublicProfileResponse": {
             "type": "object",
             "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "first_name": {
+                    "type": "string"
+                },
                 "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "post_count": {
                     "type": "integer"
                 },
-                "name": {
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.PostResponse"
+                    }
+                },
+                "username": {
+                    "type": "string"
+                },
+                "website": {
                     "type": "string"
                 }
             }
         },
-        "responses.CommentResponse": {
+        "responses.ReactionResponse": {
             "type": "object",
             "properties": {
-                "author_id": {
-                    "type": "string"
-                },
-                "content": {
-                    "type": "string"
+                "comment_id": {
+                    "type": "integer"
                 },
                 "created_at": {
                     "type": "string"
@@ -1286,88 +6564,107 @@ const docTemplate = `{
                 "post_id": {
                     "type": "integer"
                 },
-                "updated_at": {
+                "type": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "responses.CommentsResponse": {
+        "responses.ReactionsResponse": {
             "type": "object",
             "properties": {
-                "comments": {
+                "reactions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/responses.CommentResponse"
+                        "$ref": "#/definitions/responses.ReactionResponse"
                     }
                 }
             }
         },
-        "responses.ErrorResponse": {
+        "responses.RecoveryCodesResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "recovery_codes": {
+                    "description": "Yalnızca bir kez gösterilir",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "responses.LoginResponse": {
+        "responses.RegisterResponse": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
                 },
-                "token": {
-                    "type": "string"
+                "user": {
+                    "$ref": "#/definitions/responses.UserResponse"
                 }
             }
         },
-        "responses.MessageResponse": {
+        "responses.RevisionDiffResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "content": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.DiffOp"
+                    }
+                },
+                "from": {
+                    "type": "integer"
+                },
+                "mode": {
                     "type": "string"
+                },
+                "title": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.DiffOp"
+                    }
+                },
+                "to": {
+                    "type": "integer"
                 }
             }
         },
-        "responses.PostResponse": {
+        "responses.RoleResponse": {
             "type": "object",
             "properties": {
-                "author_id": {
-                    "type": "string"
-                },
-                "content": {
-                    "type": "string"
-                },
-                "created_at": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "title": {
+                "name": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "require_mfa": {
+                    "type": "boolean"
                 }
             }
         },
-        "responses.PostsResponse": {
+        "responses.SearchResponse": {
             "type": "object",
             "properties": {
-                "posts": {
+                "results": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/responses.PostResponse"
+                        "$ref": "#/definitions/responses.SearchResultResponse"
                     }
                 }
             }
         },
-        "responses.ReactionResponse": {
+        "responses.SearchResultResponse": {
             "type": "object",
             "properties": {
-                "comment_id": {
-                    "type": "integer"
+                "author_id": {
+                    "type": "string"
                 },
                 "created_at": {
                     "type": "string"
@@ -1378,47 +6675,102 @@ const docTemplate = `{
                 "post_id": {
                     "type": "integer"
                 },
-                "type": {
+                "post_slug": {
                     "type": "string"
                 },
-                "user_id": {
+                "post_title": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "snippet": {
+                    "description": "Eşleşmeler \u003cmark\u003e ile işaretlenmiş HTML",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "post veya comment",
                     "type": "string"
                 }
             }
         },
-        "responses.ReactionsResponse": {
+        "responses.SessionExport": {
             "type": "object",
             "properties": {
-                "reactions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/responses.ReactionResponse"
-                    }
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
                 }
             }
         },
-        "responses.RegisterResponse": {
+        "responses.TOTPSetupResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "otpauth_uri": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/responses.UserResponse"
+                "qr_code": {
+                    "description": "otpauth adresini içeren PNG, data URI olarak",
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
                 }
             }
         },
-        "responses.RoleResponse": {
+        "responses.TagMergeResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "moved_posts": {
+                    "type": "integer"
+                },
+                "target": {
+                    "$ref": "#/definitions/responses.TagResponse"
+                }
+            }
+        },
+        "responses.TagResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.TagUsageResponse": {
+            "type": "object",
+            "properties": {
                 "id": {
                     "type": "integer"
                 },
                 "name": {
                     "type": "string"
+                },
+                "post_count": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
                 }
             }
         },
@@ -1429,6 +6781,9 @@ const docTemplate = `{
                     "description": "Boş ise gösterilmez",
                     "type": "string"
                 },
+                "email_verified": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -1436,6 +6791,46 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "utils.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "crv": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                },
+                "x": {
+                    "type": "string"
+                }
+            }
+        },
+        "utils.JWKSet": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.JWK"
+                    }
+                }
+            }
         }
     }
 }`
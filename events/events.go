@@ -0,0 +1,86 @@
+// Package events uygulama içi olayları yayınlar. Yayın akışı gibi alt
+// sistemler olay üretir; arama indeksi, beslemeler veya bildirimler gibi
+// diğerleri Subscribe ile bu olaylara abone olur.
+package events
+
+import (
+	"blog-platform/models"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// PostPublished bir post yayına girdiğinde yayınlanır; yük PostStatusChanged'dir.
+	PostPublished = "post.published"
+	// PostUnpublished yayımlanmış bir post yayından çıktığında yayınlanır; yük PostStatusChanged'dir.
+	PostUnpublished = "post.unpublished"
+	// ContentChanged yayımlanmış bir postun düzenlenmesi veya silinmesi, bir
+	// kategori ya da etiketin değişmesi ve yazar hesabının değişmesi gibi
+	// yayındaki içeriğin görünümünü etkileyen diğer değişikliklerde yayınlanır;
+	// yükü yoktur.
+	ContentChanged = "content.changed"
+)
+
+// PostStatusChanged post olaylarının yüküdür. ActorID zamanlayıcının yaptığı
+// değişikliklerde uuid.Nil'dir.
+type PostStatusChanged struct {
+	PostID     uint
+	AuthorID   uuid.UUID
+	ActorID    uuid.UUID
+	FromStatus string
+	ToStatus   string
+	At         time.Time
+}
+
+// Handler bir olayın yükünü işler.
+type Handler func(payload interface{})
+
+var (
+	mu       sync.RWMutex
+	handlers = map[string][]Handler{}
+)
+
+// Subscribe verilen konudaki olaylar için bir handler kaydeder.
+func Subscribe(topic string, handler Handler) {
+	mu.Lock()
+	defer mu.Unlock()
+	handlers[topic] = append(handlers[topic], handler)
+}
+
+// Publish olayı konuya abone olan her handler'a ayrı bir goroutine'de iletir;
+// yavaş veya panik yapan bir handler yayıncıyı ve diğer handler'ları etkilemez.
+// Olaylar yalnızca bu süreçteki abonelere iletilir ve kalıcı değildir.
+func Publish(topic string, payload interface{}) {
+	mu.RLock()
+	subscribers := append([]Handler(nil), handlers[topic]...)
+	mu.RUnlock()
+
+	for _, handler := range subscribers {
+		go func(handler Handler) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("Event handler for %s panicked: %v", topic, r)
+				}
+			}()
+			handler(payload)
+		}(handler)
+	}
+}
+
+// PublishContentChanged ContentChanged olayını yayınlar.
+func PublishContentChanged() {
+	Publish(ContentChanged, nil)
+}
+
+// PublishPostStatusChange bir durum değişikliği için uygun post olaylarını yayınlar.
+func PublishPostStatusChange(change PostStatusChanged) {
+	switch {
+	case change.ToStatus == models.PostStatusPublished:
+		Publish(PostPublished, change)
+	case change.FromStatus == models.PostStatusPublished:
+		Publish(PostUnpublished, change)
+	}
+}
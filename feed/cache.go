@@ -0,0 +1,76 @@
+package feed
+
+import (
+	"blog-platform/events"
+	"sync"
+	"time"
+)
+
+// Entry önbellekteki kodlanmış bir beslemedir.
+type Entry struct {
+	Body     []byte
+	ETag     string
+	Modified time.Time
+	expires  time.Time
+}
+
+// Cache kodlanmış beslemeleri istek yoluna göre TTL süresince tutar. Bir post
+// yayına girdiğinde, yayından çıktığında veya yayındaki içerik başka bir
+// şekilde değiştiğinde (events.ContentChanged) tüm beslemeler geçersizleşir.
+type Cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]Entry
+}
+
+// Feeds uygulama genelindeki besleme önbelleğidir; InitCache ile kurulur.
+var Feeds = &Cache{entries: map[string]Entry{}}
+
+// InitCache önbellek süresini ayarlar ve yayın olaylarına abone olur. ttl
+// sıfırsa önbellek devre dışıdır.
+func InitCache(ttl time.Duration) {
+	Feeds.mu.Lock()
+	Feeds.ttl = ttl
+	Feeds.mu.Unlock()
+
+	purge := func(interface{}) { Feeds.Purge() }
+	events.Subscribe(events.PostPublished, purge)
+	events.Subscribe(events.PostUnpublished, purge)
+	events.Subscribe(events.ContentChanged, purge)
+}
+
+// TTL önbellek süresidir; istemcilere Cache-Control ile de bildirilir.
+func (c *Cache) TTL() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ttl
+}
+
+// Get süresi dolmamış kaydı döner.
+func (c *Cache) Get(key string) (Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// Set kaydı TTL süresince saklar.
+func (c *Cache) Set(key string, entry Entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 {
+		return
+	}
+	entry.expires = time.Now().Add(c.ttl)
+	c.entries[key] = entry
+}
+
+// Purge tüm kayıtları siler.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]Entry{}
+}
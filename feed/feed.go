@@ -0,0 +1,93 @@
+// Package feed yayımlanmış postları RSS 2.0, Atom 1.0 ve JSON Feed 1.1
+// biçimlerinde sunar. Beslemenin içeriği biçimden bağımsız Feed yapısında
+// toplanır; her biçim bu yapıyı kendi kodlayıcısıyla yazar.
+package feed
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// summaryLength özetlerin karakter cinsinden en fazla uzunluğudur.
+const summaryLength = 280
+
+const (
+	FormatRSS  = "rss"
+	FormatAtom = "atom"
+	FormatJSON = "json"
+)
+
+// ContentTypes her biçimin yanıtta kullanılan medya türüdür.
+var ContentTypes = map[string]string{
+	FormatRSS:  "application/rss+xml; charset=utf-8",
+	FormatAtom: "application/atom+xml; charset=utf-8",
+	FormatJSON: "application/feed+json; charset=utf-8",
+}
+
+// Feed bir beslemenin biçimden bağımsız halidir. Link beslemenin anlattığı
+// kaynağın, FeedURL beslemenin kendi adresidir.
+type Feed struct {
+	Title       string
+	Description string
+	Link        string
+	FeedURL     string
+	Updated     time.Time
+	Items       []Item
+}
+
+// Item beslemedeki bir posttur. ContentHTML temizlenmiş HTML, Summary ise
+// içeriğin düz metin özetidir.
+type Item struct {
+	ID          string
+	Title       string
+	Link        string
+	ContentHTML string
+	Summary     string
+	Author      Author
+	Tags        []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+type Author struct {
+	Name string
+	URL  string
+}
+
+// LastUpdated öğelerin en son güncellenme zamanını döner; öğe yoksa fallback döner.
+func LastUpdated(items []Item, fallback time.Time) time.Time {
+	latest := fallback
+	for _, item := range items {
+		if item.Updated.After(latest) {
+			latest = item.Updated
+		}
+	}
+	return latest
+}
+
+// Summarize düz metni tek satıra indirir ve uzunsa kelime sınırından keserek
+// sonuna "…" ekler.
+func Summarize(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= summaryLength {
+		return text
+	}
+	cut := string([]rune(text)[:summaryLength])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}
+
+// Encode beslemeyi istenen biçimde yazar.
+func Encode(f Feed, format string) ([]byte, error) {
+	switch format {
+	case FormatAtom:
+		return encodeAtom(f)
+	case FormatJSON:
+		return encodeJSON(f)
+	default:
+		return encodeRSS(f)
+	}
+}
@@ -0,0 +1,273 @@
+package jobs
+
+import (
+	"blog-platform/database"
+	"blog-platform/events"
+	"blog-platform/models"
+	"blog-platform/search"
+	"blog-platform/spam"
+	"blog-platform/utils"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// StartAccountPurge bekleme süresi dolmuş hesap silme taleplerini verilen
+// aralıklarla arka planda tamamlar.
+func StartAccountPurge(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			purged, err := PurgeDeletedAccounts(database.DB, time.Now())
+			if err != nil {
+				log.Printf("Account purge failed: %v", err)
+			} else if purged > 0 {
+				log.Printf("Purged %d deleted account(s)", purged)
+				events.PublishContentChanged()
+			}
+			<-ticker.C
+		}
+	}()
+}
+
+// PurgeDeletedAccounts silinme zamanı gelmiş hesapları siler ve silinen hesap
+// sayısını döner. Her hesap kendi transaction'ında işlenir; biri başarısız
+// olursa diğerleri etkilenmez ve bir sonraki çalışmada tekrar denenir.
+func PurgeDeletedAccounts(db *gorm.DB, now time.Time) (int, error) {
+	var users []models.User
+	if err := db.Where("deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= ?", now).Find(&users).Error; err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, user := range users {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			return purgeAccount(tx, user)
+		})
+		if err != nil {
+			log.Printf("Could not purge account %s: %v", user.ID, err)
+			continue
+		}
+		if strings.HasPrefix(user.AvatarURL, "/uploads/avatars/") {
+			os.Remove(filepath.Join(utils.UploadDir(), "avatars", filepath.Base(user.AvatarURL)))
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func purgeAccount(tx *gorm.DB, user models.User) error {
+	// Talep bu arada iptal edildiyse dokunma
+	var count int64
+	if err := tx.Model(&models.User{}).Where("id = ? AND deletion_scheduled_at IS NOT NULL", user.ID).Count(&count).Error; err != nil || count == 0 {
+		return err
+	}
+
+	if err := ensureDeletedUser(tx); err != nil {
+		return err
+	}
+
+	if user.DeletionMode == models.DeletionModeDelete {
+		if err := deleteAuthoredContent(tx, user); err != nil {
+			return err
+		}
+	} else {
+		for _, model := range []interface{}{&models.Post{}, &models.Comment{}} {
+			if err := tx.Unscoped().Model(model).Where("author_id = ?", user.ID).Update("author_id", models.DeletedUserID).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	// Kategoriler ortak içeriktir; silme modundan bağımsız olarak devredilir
+	if err := tx.Unscoped().Model(&models.Category{}).Where("created_by = ?", user.ID).Update("created_by", models.DeletedUserID).Error; err != nil {
+		return err
+	}
+	// Başkalarının postlarındaki editör ve moderatör işlemleri ile yönetici
+	// işlemleri geçmişte kalır
+	for _, model := range []interface{}{&models.PostStatusChange{}, &models.CommentModeration{}, &models.AuditLog{}} {
+		if err := tx.Model(model).Where("actor_id = ?", user.ID).Update("actor_id", models.DeletedUserID).Error; err != nil {
+			return err
+		}
+	}
+	for _, model := range []interface{}{&models.PostRevision{}, &models.CommentRevision{}} {
+		if err := tx.Model(model).Where("editor_id = ?", user.ID).Update("editor_id", models.DeletedUserID).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := deletePersonalData(tx, user); err != nil {
+		return err
+	}
+
+	return tx.Create(&models.AuditLog{
+		Action:  models.AuditActionAccountPurge,
+		Details: fmt.Sprintf("account %s purged, content %s", user.ID, user.DeletionMode),
+	}).Error
+}
+
+// ensureDeletedUser anonimleştirilen içeriğin sahibi olacak, giriş
+// yapılamayan yer tutucu kullanıcıyı oluşturur.
+func ensureDeletedUser(tx *gorm.DB) error {
+	placeholder := models.User{
+		ID:       models.DeletedUserID,
+		Username: models.DeletedUsername,
+		Email:    "deleted@invalid",
+	}
+	return tx.Where("id = ?", models.DeletedUserID).FirstOrCreate(&placeholder).Error
+}
+
+// deleteAuthoredContent kullanıcının yazılarını ve yorumlarını, bunlara bağlı
+// yorum ve tepkilerle birlikte kalıcı olarak siler.
+func deleteAuthoredContent(tx *gorm.DB, user models.User) error {
+	var postIDs []uint
+	if err := tx.Unscoped().Model(&models.Post{}).Where("author_id = ?", user.ID).Pluck("id", &postIDs).Error; err != nil {
+		return err
+	}
+
+	var commentIDs []uint
+	if err := tx.Unscoped().Model(&models.Comment{}).Where("author_id = ? OR post_id IN ?", user.ID, postIDs).Pluck("id", &commentIDs).Error; err != nil {
+		return err
+	}
+
+	// Başkalarının yanıtladığı yorumlar, tartışma kopmasın diye içerikleri
+	// temizlenmiş "[deleted]" yer tutucuları olarak kalır
+	kept, err := repliedComments(tx, commentIDs)
+	if err != nil {
+		return err
+	}
+	keptIDs := make([]uint, 0, len(kept))
+	removedIDs := make([]uint, 0, len(commentIDs))
+	for _, id := range commentIDs {
+		if kept[id] {
+			keptIDs = append(keptIDs, id)
+		} else {
+			removedIDs = append(removedIDs, id)
+		}
+	}
+	if len(keptIDs) > 0 {
+		err := tx.Unscoped().Model(&models.Comment{}).Where("id IN ?", keptIDs).Updates(map[string]interface{}{
+			"content":      "",
+			"content_html": "",
+			"author_id":    models.DeletedUserID,
+			"removed_at":   time.Now(),
+			"version":      gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Where("post_id IN ? OR comment_id IN ?", postIDs, removedIDs).Delete(&models.Reaction{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("comment_id IN ?", commentIDs).Delete(&models.CommentRevision{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("comment_id IN ?", removedIDs).Delete(&models.CommentModeration{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Where("id IN ?", removedIDs).Delete(&models.Comment{}).Error; err != nil {
+		return err
+	}
+	if err := search.RemoveComments(tx, commentIDs...); err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM post_categories WHERE post_id IN ?", postIDs).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM post_tags WHERE post_id IN ?", postIDs).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("post_id IN ?", postIDs).Delete(&models.PostStatusChange{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("post_id IN ?", postIDs).Delete(&models.PostRevision{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("post_id IN ?", postIDs).Delete(&models.PostSlugRedirect{}).Error; err != nil {
+		return err
+	}
+	if err := search.RemovePosts(tx, postIDs...); err != nil {
+		return err
+	}
+	return tx.Unscoped().Where("id IN ?", postIDs).Delete(&models.Post{}).Error
+}
+
+// repliedComments silinecek yorumlardan, kümenin dışındaki bir yanıtın
+// atası olanları döner; bu yorumlar silinirse yanıtlar sahipsiz kalır.
+func repliedComments(tx *gorm.DB, commentIDs []uint) (map[uint]bool, error) {
+	kept := make(map[uint]bool)
+	if len(commentIDs) == 0 {
+		return kept, nil
+	}
+
+	var rows []struct {
+		ID       uint
+		ParentID *uint
+	}
+	if err := tx.Unscoped().Model(&models.Comment{}).Select("id", "parent_id").Where("id IN ?", commentIDs).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	parentOf := make(map[uint]*uint, len(rows))
+	for _, row := range rows {
+		parentOf[row.ID] = row.ParentID
+	}
+
+	var repliedIDs []uint
+	if err := tx.Model(&models.Comment{}).Distinct().Where("parent_id IN ? AND id NOT IN ?", commentIDs, commentIDs).Pluck("parent_id", &repliedIDs).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range repliedIDs {
+		// Yer tutucunun kümedeki ataları da kalmalıdır
+		for current := &id; current != nil && !kept[*current]; current = parentOf[*current] {
+			if _, ok := parentOf[*current]; !ok {
+				break
+			}
+			kept[*current] = true
+		}
+	}
+	return kept, nil
+}
+
+// deletePersonalData kullanıcının kimlik bilgilerini, oturumlarını,
+// tepkilerini, denetim kayıtlarını ve spam kontrolü için tutulan gönderim
+// kayıtlarını siler, ardından kullanıcı kaydını kaldırır.
+func deletePersonalData(tx *gorm.DB, user models.User) error {
+	sessionIDs := tx.Model(&models.Session{}).Select("id").Where("user_id = ?", user.ID)
+	if err := tx.Where("session_id IN (?)", sessionIDs).Delete(&models.RefreshToken{}).Error; err != nil {
+		return err
+	}
+
+	for _, model := range []interface{}{
+		&models.Reaction{},
+		&models.Session{},
+		&models.PersonalAccessToken{},
+		&models.UserToken{},
+		&models.RecoveryCode{},
+		&models.UserIdentity{},
+		&models.AuditLog{},
+	} {
+		if err := tx.Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := spam.ForgetUser(tx, user.ID); err != nil {
+		return err
+	}
+	if err := tx.Model(&user).Association("Roles").Clear(); err != nil {
+		return err
+	}
+	if err := tx.Where("key = ?", "email:"+strings.ToLower(user.Email)).Delete(&models.LoginThrottle{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(&user).Error
+}
@@ -0,0 +1,123 @@
+package jobs
+
+import (
+	"blog-platform/database"
+	"blog-platform/events"
+	"blog-platform/models"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// postScheduleBatchSize tek transaction'da işlenen en fazla post sayısıdır.
+const postScheduleBatchSize = 100
+
+// StartPostScheduler zamanı gelmiş postları verilen aralıklarla yayımlar veya
+// arşivler. Zamanlama veritabanında tutulduğundan yeniden başlatmada kaçırılan
+// postlar ilk çalışmada işlenir.
+func StartPostScheduler(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			published, archived, err := RunPostSchedule(database.DB, time.Now())
+			if err != nil {
+				log.Printf("Post scheduler failed: %v", err)
+			} else if published > 0 || archived > 0 {
+				log.Printf("Post scheduler published %d and archived %d post(s)", published, archived)
+			}
+			<-ticker.C
+		}
+	}()
+}
+
+// RunPostSchedule publish_at zamanı gelmiş zamanlanmış postları yayımlar,
+// unpublish_at zamanı gelmiş yayımlanmış postları arşivler ve her biri için
+// post olayını yayınlar.
+func RunPostSchedule(db *gorm.DB, now time.Time) (int, int, error) {
+	published, err := processDuePosts(db, now, models.PostStatusScheduled, "publish_at", models.PostStatusPublished, map[string]interface{}{
+		"status":       models.PostStatusPublished,
+		"published_at": gorm.Expr("COALESCE(published_at, publish_at)"),
+		"publish_at":   nil,
+		"version":      gorm.Expr("version + 1"),
+	})
+	if err != nil {
+		return published, 0, err
+	}
+
+	archived, err := processDuePosts(db, now, models.PostStatusPublished, "unpublish_at", models.PostStatusArchived, map[string]interface{}{
+		"status":       models.PostStatusArchived,
+		"unpublish_at": nil,
+		"version":      gorm.Expr("version + 1"),
+	})
+	return published, archived, err
+}
+
+// processDuePosts from durumundaki ve column zamanı gelmiş postları gruplar
+// halinde to durumuna taşır. Birden fazla sunucu aynı veritabanında çalışırken
+// Postgres'te satırlar FOR UPDATE SKIP LOCKED ile kilitlenir, böylece her post
+// tek bir sunucu tarafından alınır; SQLite kilitleme ifadesini yok sayar ancak
+// yazmaları zaten sıraya koyar. Güncelleme ayrıca durumu koşul olarak
+// kullandığından bir post hiçbir durumda iki kez işlenmez.
+func processDuePosts(db *gorm.DB, now time.Time, from, column, to string, updates map[string]interface{}) (int, error) {
+	processed := 0
+	for {
+		var posts []models.Post
+		var changes []events.PostStatusChanged
+		err := db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("status = ? AND "+column+" <= ?", from, now).
+				Order(column).Limit(postScheduleBatchSize).
+				Find(&posts).Error
+			if err != nil {
+				return err
+			}
+
+			for _, post := range posts {
+				result := tx.Model(&models.Post{}).Where("id = ? AND status = ?", post.ID, from).Updates(updates)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					continue
+				}
+
+				change := models.PostStatusChange{
+					PostID:     post.ID,
+					FromStatus: from,
+					ToStatus:   to,
+					ActorID:    models.SystemActorID,
+					Note:       "Automatically " + to + " by the scheduler",
+				}
+				if err := tx.Create(&change).Error; err != nil {
+					return err
+				}
+				changes = append(changes, events.PostStatusChanged{
+					PostID:     post.ID,
+					AuthorID:   post.AuthorID,
+					ActorID:    models.SystemActorID,
+					FromStatus: from,
+					ToStatus:   to,
+					At:         change.CreatedAt,
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return processed, err
+		}
+
+		// Olaylar yalnızca transaction kalıcı olduktan sonra yayınlanır
+		for _, change := range changes {
+			events.PublishPostStatusChange(change)
+		}
+		processed += len(changes)
+
+		if len(posts) < postScheduleBatchSize {
+			return processed, nil
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LogMailer e-postaları göndermek yerine uygulama loguna yazar.
+// Yerel geliştirme içindir.
+type LogMailer struct{}
+
+func (LogMailer) Send(message Message) error {
+	log.Printf("Mail to %s: %s\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
+
+// FileMailer her e-postayı Dir altında ayrı bir .eml dosyasına yazar.
+// Testlerde ve yerel ortamda gönderilen e-postaları incelemek için kullanılır.
+type FileMailer struct {
+	Dir  string
+	From string
+}
+
+func (m FileMailer) Send(message Message) error {
+	if err := os.MkdirAll(m.Dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d.eml", time.Now().UnixNano())
+	return os.WriteFile(filepath.Join(m.Dir, name), buildMessage(m.From, message), 0o644)
+}
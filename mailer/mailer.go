@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"log"
+	"os"
+)
+
+// Message gönderilecek düz metin e-postadır.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer e-posta gönderim altyapısını soyutlar.
+type Mailer interface {
+	Send(message Message) error
+}
+
+// Default uygulama genelinde kullanılan mailer'dır.
+var Default Mailer = LogMailer{}
+
+// InitMailer MAIL_DRIVER ortam değişkenine göre mailer'ı seçer:
+// "smtp", "file" veya "log" (varsayılan).
+func InitMailer() {
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = "no-reply@localhost"
+	}
+
+	switch os.Getenv("MAIL_DRIVER") {
+	case "smtp":
+		Default = SMTPMailer{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     os.Getenv("SMTP_PORT"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		}
+	case "file":
+		dir := os.Getenv("MAIL_FILE_DIR")
+		if dir == "" {
+			dir = "mail"
+		}
+		Default = FileMailer{Dir: dir, From: from}
+	default:
+		Default = LogMailer{}
+	}
+
+	log.Printf("Mailer initialized (%T)", Default)
+}
+
+// Send mesajı varsayılan mailer ile gönderir.
+func Send(message Message) error {
+	return Default.Send(message)
+}
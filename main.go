@@ -2,10 +2,18 @@ package main
 
 import (
 	"blog-platform/database"
+	"blog-platform/feed"
+	"blog-platform/jobs"
+	"blog-platform/mailer"
 	"blog-platform/middleware"
+	"blog-platform/oidc"
 	"blog-platform/routes"
 	"blog-platform/utils"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -17,8 +25,20 @@ func main() {
 	}
 	database.InitDatabase()
 
+	if err := utils.InitKeyManager(); err != nil {
+		log.Fatalf("failed to load JWT signing keys: %v", err)
+	}
+	go reloadKeysOnSignal()
+
+	mailer.InitMailer()
+	oidc.InitProvider()
+
 	utils.SeedRoles(database.DB)
 
+	jobs.StartAccountPurge(utils.GetEnvPositiveDuration("ACCOUNT_PURGE_INTERVAL", time.Hour))
+	jobs.StartPostScheduler(utils.GetEnvPositiveDuration("POST_SCHEDULER_INTERVAL", time.Minute))
+	feed.InitCache(utils.GetEnvDuration("FEED_CACHE_TTL", 5*time.Minute))
+
 	router := routes.SetupRouter()
 
 	router.Use(middleware.CORSMiddleware())
@@ -28,3 +48,17 @@ func main() {
 		log.Println("Err while running")
 	}
 }
+
+// reloadKeysOnSignal SIGHUP alındığında JWT anahtarlarını yeniden yükler;
+// böylece anahtar rotasyonu için sunucuyu yeniden başlatmak gerekmez.
+func reloadKeysOnSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	for range signals {
+		if err := utils.Keys.Reload(); err != nil {
+			log.Printf("Could not reload JWT signing keys: %v", err)
+			continue
+		}
+		log.Println("JWT signing keys reloaded")
+	}
+}
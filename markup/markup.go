@@ -0,0 +1,162 @@
+// Package markup kullanıcı içeriğini (markdown, düz metin veya HTML) güvenli
+// HTML'e dönüştürür. Çıktı her zaman izin listesi tabanlı bir politikadan geçer,
+// bu nedenle istemciler content_html alanını doğrudan sayfaya yerleştirebilir.
+package markup
+
+import (
+	"blog-platform/utils"
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+const (
+	FormatMarkdown = "markdown"
+	FormatPlain    = "plain"
+	FormatHTML     = "html"
+)
+
+// Formats desteklenen içerik biçimleridir.
+var Formats = []string{FormatMarkdown, FormatPlain, FormatHTML}
+
+// IsValidFormat biçimin Formats içinde olup olmadığını döner.
+func IsValidFormat(format string) bool {
+	for _, candidate := range Formats {
+		if candidate == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Audience içeriğin kimin tarafından yazıldığını belirtir; yorumlardaki
+// bağlantılar arama motorlarına önerilmez.
+type Audience int
+
+const (
+	AudiencePost Audience = iota
+	AudienceComment
+)
+
+var (
+	// Postlardaki başlıklar bağlantı verilebilmesi için id alır; yorumlarda
+	// bu id'ler sayfadaki post başlıklarıyla çakışacağından kullanılmaz.
+	postMarkdown    = newMarkdown(parser.WithAutoHeadingID())
+	commentMarkdown = newMarkdown()
+
+	postPolicy    = newPolicy(false)
+	commentPolicy = newPolicy(true)
+	textPolicy    = bluemonday.StrictPolicy()
+
+	// UGC politikası id özniteliğine her etikette izin verir ve bluemonday'de
+	// izin geri alınamaz; yorumlardaki id'ler temizlendikten sonra silinir.
+	// Temizlenmiş çıktıda metin ve öznitelik değerlerindeki tırnaklar
+	// kaçışlandığından bu desen yalnızca gerçek id özniteliklerine uyar.
+	idAttribute = regexp.MustCompile(` id="[^"]*"`)
+)
+
+// newMarkdown GFM destekli bir dönüştürücü oluşturur. Kod blokları satır içi
+// stillerle renklendirilir; böylece istemcinin ayrıca CSS yüklemesi gerekmez.
+func newMarkdown(parserOptions ...parser.Option) goldmark.Markdown {
+	return goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			highlighting.NewHighlighting(highlighting.WithStyle("github")),
+		),
+		goldmark.WithParserOptions(parserOptions...),
+		// Ham HTML de işlenir; çıktı yine de temizlendiğinden güvenlidir
+		goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+	)
+}
+
+// newPolicy UGC politikasını kod renklendirmesinin ürettiği stillere izin
+// verecek şekilde genişletir.
+func newPolicy(noFollow bool) *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.RequireNoFollowOnLinks(noFollow)
+	policy.AllowStyles("color", "background-color", "font-weight", "font-style", "text-decoration").
+		OnElements("span", "pre")
+	return policy
+}
+
+// PlainText üretilmiş HTML'den etiketleri atarak düz metni döner; arama
+// indeksi gibi biçimlendirmenin önemli olmadığı yerler içindir.
+func PlainText(rendered string) string {
+	return html.UnescapeString(textPolicy.Sanitize(rendered))
+}
+
+// Render içeriği verilen biçimden temizlenmiş HTML'e dönüştürür. Bilinmeyen
+// biçimler düz metin olarak ele alınır.
+func Render(format, content string, audience Audience) (string, error) {
+	var rendered string
+	switch format {
+	case FormatMarkdown:
+		converter := postMarkdown
+		if audience == AudienceComment {
+			converter = commentMarkdown
+		}
+		var buf bytes.Buffer
+		ctx := parser.NewContext(parser.WithIDs(&headingIDs{used: map[string]bool{}}))
+		if err := converter.Convert([]byte(content), &buf, parser.WithContext(ctx)); err != nil {
+			return "", err
+		}
+		rendered = buf.String()
+	case FormatHTML:
+		rendered = content
+	default:
+		rendered = renderPlain(content)
+	}
+
+	if audience == AudienceComment {
+		return idAttribute.ReplaceAllString(commentPolicy.Sanitize(rendered), ""), nil
+	}
+	return postPolicy.Sanitize(rendered), nil
+}
+
+// renderPlain metni kaçışlar; boş satırlar paragrafları, tek satır sonları
+// <br> etiketlerini oluşturur.
+func renderPlain(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var b strings.Builder
+	for _, paragraph := range strings.Split(content, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if paragraph == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
+
+// headingIDs başlık id'lerini URL slug'larıyla aynı kurallarla üretir ve aynı
+// belgede tekrar eden başlıklara sayı ekler.
+type headingIDs struct {
+	used map[string]bool
+}
+
+func (ids *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	base := utils.SlugWithFallback(string(value), "heading")
+	candidate := base
+	for i := 1; ids.used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+	ids.used[candidate] = true
+	return []byte(candidate)
+}
+
+func (ids *headingIDs) Put(value []byte) {
+	ids.used[string(value)] = true
+}
@@ -3,15 +3,54 @@ package middleware
 import (
 	"blog-platform/database"
 	"blog-platform/models"
+	"blog-platform/utils"
 	"net/http"
 	"strings"
+	"time"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
+// personalAccessTokenTouchInterval last_used_at alanının her istekte değil
+// en fazla bu aralıkla güncellenmesini sağlar.
+const personalAccessTokenTouchInterval = time.Minute
+
+// AuthMiddleware yalnızca oturum JWT'lerini kabul eder; personal access
+// token'lar kapsam tanımlanmamış uçlarda reddedilir.
 func AuthMiddleware() gin.HandlerFunc {
+	return authenticate(true, "")
+}
+
+// ScopedAuthMiddleware AuthMiddleware gibi çalışır ve ayrıca verilen kaynak
+// için kapsamı olan personal access token'ları kabul eder. GET ve HEAD
+// istekleri "<kaynak>:read", diğerleri "<kaynak>:write" kapsamı gerektirir.
+func ScopedAuthMiddleware(resource string) gin.HandlerFunc {
+	return authenticate(true, resource)
+}
+
+// OptionalScopedAuthMiddleware Authorization başlığı yoksa isteği anonim
+// olarak geçirir; başlık varsa ScopedAuthMiddleware gibi doğrular. Herkese
+// açık olup giriş yapan kullanıcıya daha fazlasını gösteren uçlar içindir.
+func OptionalScopedAuthMiddleware(resource string) gin.HandlerFunc {
+	required := authenticate(true, resource)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		required(c)
+	}
+}
+
+// EnrollmentAuthMiddleware AuthMiddleware gibi çalışır ancak rolü iki adımlı
+// doğrulama gerektirdiği halde henüz TOTP kurmamış kullanıcıları da geçirir.
+// Yalnızca 2FA kurulumu ve oturum kapatma gibi uçlarda kullanılmalıdır.
+func EnrollmentAuthMiddleware() gin.HandlerFunc {
+	return authenticate(false, "")
+}
+
+func authenticate(enforceMFA bool, scopeResource string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenString := c.GetHeader("Authorization")
 
@@ -22,36 +61,54 @@ func AuthMiddleware() gin.HandlerFunc {
 		}
 
 		tokenString = strings.TrimPrefix(tokenString, "Bearer ")
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			return []byte("your_secret_key"), nil
-		})
 
-		if err != nil || !token.Valid {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			c.Abort()
-			return
-		}
+		var userID uuid.UUID
+		if strings.HasPrefix(tokenString, utils.PersonalAccessTokenPrefix) {
+			if scopeResource == "" {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Personal access tokens cannot be used for this endpoint"})
+				c.Abort()
+				return
+			}
 
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
-			c.Abort()
-			return
-		}
+			token, ok := lookupPersonalAccessToken(tokenString)
+			if !ok {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+				c.Abort()
+				return
+			}
 
-		// user_id'yi UUID olarak çözümleme
-		userIDStr, ok := claims["user_id"].(string)
-		if !ok {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID format"})
-			c.Abort()
-			return
-		}
+			write := c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead
+			if !utils.ScopeAllows(token.ScopeList(), scopeResource, write) {
+				required := scopeResource + ":read"
+				if write {
+					required = scopeResource + ":write"
+				}
+				c.JSON(http.StatusForbidden, gin.H{"error": "Token is missing the " + required + " scope"})
+				c.Abort()
+				return
+			}
 
-		userID, err := uuid.Parse(userIDStr)
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID"})
-			c.Abort()
-			return
+			userID = token.UserID
+			c.Set("token_id", token.ID)
+		} else {
+			var sessionID uuid.UUID
+			var err error
+			userID, sessionID, err = utils.ParseJWT(tokenString)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+				c.Abort()
+				return
+			}
+
+			// Logout ile iptal edilmiş oturumlara ait token'lar reddedilir
+			var session models.Session
+			if err := database.DB.Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).First(&session).Error; err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Session has been revoked"})
+				c.Abort()
+				return
+			}
+
+			c.Set("session_id", sessionID)
 		}
 
 		var user models.User
@@ -61,7 +118,33 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		if enforceMFA && !user.TOTPEnabled && utils.RequiresMFA(user) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Two-factor authentication must be enabled for your role"})
+			c.Abort()
+			return
+		}
+
 		c.Set("user", user)
 		c.Next()
 	}
 }
+
+// lookupPersonalAccessToken iptal edilmemiş ve süresi dolmamış token'ı bulur,
+// gerekiyorsa son kullanım zamanını günceller.
+func lookupPersonalAccessToken(tokenString string) (models.PersonalAccessToken, bool) {
+	var token models.PersonalAccessToken
+	err := database.DB.Where("token_hash = ? AND revoked_at IS NULL", utils.HashToken(tokenString)).First(&token).Error
+	if err != nil {
+		return token, false
+	}
+
+	now := time.Now()
+	if token.ExpiresAt != nil && now.After(*token.ExpiresAt) {
+		return token, false
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > personalAccessTokenTouchInterval {
+		database.DB.Model(&token).UpdateColumn("last_used_at", now)
+	}
+	return token, true
+}
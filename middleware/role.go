@@ -26,3 +26,25 @@ func RequireRole(roleName string) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// RequireAnyRole kullanıcının verilen rollerden en az birine sahip olmasını şart koşar.
+func RequireAnyRole(roleNames ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, exists := c.Get("user")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Kullanıcı oturum açmamış"})
+			c.Abort()
+			return
+		}
+
+		for _, roleName := range roleNames {
+			if utils.HasRole(user.(models.User), roleName) {
+				c.Next()
+				return
+			}
+		}
+
+		c.JSON(http.StatusForbidden, gin.H{"error": "Yetkisiz erişim"})
+		c.Abort()
+	}
+}
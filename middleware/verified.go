@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"blog-platform/models"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireVerifiedEmail REQUIRE_VERIFIED_EMAIL=true ise email adresi doğrulanmamış
+// kullanıcıların içerik oluşturmasını engeller. AuthMiddleware'den sonra kullanılmalıdır.
+func RequireVerifiedEmail() gin.HandlerFunc {
+	enabled := os.Getenv("REQUIRE_VERIFIED_EMAIL") == "true"
+
+	return func(c *gin.Context) {
+		if !enabled {
+			c.Next()
+			return
+		}
+
+		user, exists := c.Get("user")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Kullanıcı oturum açmamış"})
+			c.Abort()
+			return
+		}
+
+		if !user.(models.User).EmailVerified {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Email address must be verified"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionLoginLockout = "login_lockout"
+	AuditActionLoginUnlock  = "login_unlock"
+	AuditActionAccountPurge = "account_purge"
+	AuditActionSpamFlagged  = "spam_flagged"
+	AuditActionSpamRejected = "spam_rejected"
+)
+
+// AuditLog güvenlikle ilgili olayların kalıcı kaydıdır.
+type AuditLog struct {
+	ID        uint       `gorm:"primaryKey"`
+	Action    string     `gorm:"index;not null"`
+	UserID    *uuid.UUID `gorm:"type:uuid;index"` // Olaydan etkilenen kullanıcı, biliniyorsa
+	ActorID   *uuid.UUID `gorm:"type:uuid"`       // İşlemi yapan kullanıcı, örn. kilidi açan yönetici
+	IPAddress string
+	Details   string
+	CreatedAt time.Time `gorm:"index"`
+}
@@ -7,6 +7,9 @@ import (
 
 type Category struct {
 	gorm.Model
-	Name      string    `json:"name"`
-	CreatedBy uuid.UUID `json:"created_by" gorm:"not null"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug" gorm:"uniqueIndex:idx_categories_slug,where:deleted_at IS NULL;not null;default:''"`
+	Description string    `json:"description"`
+	ParentID    *uint     `json:"parent_id" gorm:"index"`
+	CreatedBy   uuid.UUID `json:"created_by" gorm:"not null"`
 }
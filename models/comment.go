@@ -1,17 +1,29 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Comment struct {
 	gorm.Model
-	PostID    uint       `json:"post_id"`
-	Post      Post       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
-	Title     string     `json:"title"`
-	Content   string     `json:"content"`
-	AuthorID  uuid.UUID  `json:"author_id"`
-	Author    User       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"author,omitempty"`
-	Reactions []Reaction `gorm:"foreignKey:CommentID" json:"reactions,omitempty"`
+	PostID        uint       `json:"post_id"`
+	Post          Post       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	ParentID      *uint      `json:"parent_id" gorm:"index"`
+	Depth         int        `json:"depth" gorm:"not null;default:0"`
+	Title         string     `json:"title"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format" gorm:"not null;default:markdown"`
+	ContentHTML   string     `json:"content_html"`
+	AuthorID      uuid.UUID  `json:"author_id"`
+	Author        User       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"author,omitempty"`
+	Status        string     `json:"status" gorm:"index;not null;default:approved"` // pending, approved veya rejected
+	Version       int        `json:"version" gorm:"not null;default:1"`
+	RemovedAt     *time.Time `json:"removed_at,omitempty"` // Yanıtları olan yorum silinince "[deleted]" olarak kalır
+	Reactions     []Reaction `gorm:"foreignKey:CommentID" json:"reactions,omitempty"`
 }
+
+// DeletedCommentPlaceholder yanıtları olduğu için silinmeyen yorumların yerine gösterilir.
+const DeletedCommentPlaceholder = "[deleted]"
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusRejected = "rejected"
+)
+
+const (
+	// CommentModeOpen yorumları hemen yayımlar; yalnızca yeni veya güvenilmeyen kullanıcılarınki beklemeye alınır
+	CommentModeOpen = "open"
+	// CommentModeModerated tüm yorumları onaya bırakır
+	CommentModeModerated = "moderated"
+	// CommentModeClosed yeni yorumları kabul etmez
+	CommentModeClosed = "closed"
+)
+
+// CommentModes bir postun veya sitenin alabileceği yorum ayarlarıdır.
+var CommentModes = []string{CommentModeOpen, CommentModeModerated, CommentModeClosed}
+
+// IsValidCommentMode ayarın CommentModes içinde olup olmadığını döner.
+func IsValidCommentMode(mode string) bool {
+	for _, candidate := range CommentModes {
+		if candidate == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// CommentModeration bir yorum hakkında verilen her moderasyon kararının kaydıdır.
+type CommentModeration struct {
+	ID         uint      `gorm:"primaryKey"`
+	CommentID  uint      `gorm:"index;not null"`
+	FromStatus string    `gorm:"not null"`
+	ToStatus   string    `gorm:"not null"`
+	ActorID    uuid.UUID `gorm:"type:uuid;not null"` // Otomatik kararlar için SystemActorID
+	Reason     string
+	CreatedAt  time.Time
+}
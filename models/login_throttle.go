@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// LoginThrottle bir hesap ("email:<adres>") veya IP adresi ("ip:<adres>")
+// için başarısız giriş denemelerini izler. Anahtar kullanıcının var olup
+// olmamasından bağımsızdır; böylece kilitlenme hangi emaillerin kayıtlı
+// olduğunu ele vermez.
+type LoginThrottle struct {
+	ID           uint   `gorm:"primaryKey"`
+	Key          string `gorm:"uniqueIndex;not null"`
+	FailedCount  int    `gorm:"not null;default:0"`
+	LastFailedAt time.Time
+	LockedUntil  *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PersonalAccessToken CI ve betikler gibi otomasyonların şifre kullanmadan
+// API'ye erişmesi için kullanıcı tarafından oluşturulan, kapsamı sınırlı
+// token'dır. Token'ın kendisi değil yalnızca SHA-256 özeti saklanır.
+type PersonalAccessToken struct {
+	ID         uint      `gorm:"primaryKey"`
+	UserID     uuid.UUID `gorm:"type:uuid;index;not null"`
+	User       User      `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Name       string    `gorm:"not null"`
+	Prefix     string    `gorm:"not null"` // Listelemede token'ı tanımak için ilk karakterler
+	TokenHash  string    `gorm:"uniqueIndex;not null"`
+	Scopes     string    `gorm:"not null"` // Boşlukla ayrılmış kapsamlar, ör. "posts:write comments:read"
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// ScopeList kapsamları dilim olarak döner.
+func (t PersonalAccessToken) ScopeList() []string {
+	return strings.Fields(t.Scopes)
+}
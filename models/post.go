@@ -1,17 +1,50 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+const (
+	PostStatusDraft     = "draft"
+	PostStatusInReview  = "in_review"
+	PostStatusScheduled = "scheduled"
+	PostStatusPublished = "published"
+	PostStatusArchived  = "archived"
+)
+
+// PostStatuses bir postun alabileceği tüm durumlardır.
+var PostStatuses = []string{PostStatusDraft, PostStatusInReview, PostStatusScheduled, PostStatusPublished, PostStatusArchived}
+
 type Post struct {
 	gorm.Model
-	Title      string     `json:"title"`
-	Content    string     `json:"content"`
-	AuthorID   uuid.UUID  `json:"author_id"`
-	Author     User       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"author,omitempty"`
-	Reactions  []Reaction `gorm:"foreignKey:PostID" json:"reactions,omitempty"`
-	Comments   []Comment  `gorm:"foreignKey:PostID" json:"comments,omitempty"`
-	Categories []Category `gorm:"many2many:post_categories"`
+	Title         string     `json:"title"`
+	Slug          string     `json:"slug" gorm:"uniqueIndex:idx_posts_slug,where:deleted_at IS NULL;not null;default:''"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format" gorm:"not null;default:markdown"` // markdown, plain veya html
+	ContentHTML   string     `json:"content_html"`                                    // İçerik değiştiğinde yeniden üretilen temizlenmiş HTML
+	AuthorID      uuid.UUID  `json:"author_id"`
+	Author        User       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"author,omitempty"`
+	Status        string     `json:"status" gorm:"index;not null;default:draft"`
+	PublishedAt   *time.Time `json:"published_at"`
+	PublishAt     *time.Time `json:"publish_at" gorm:"index"`                 // Yalnızca scheduled durumunda dolu
+	UnpublishAt   *time.Time `json:"unpublish_at" gorm:"index"`               // Bu zamanda post arşivlenir
+	CommentMode   string     `json:"comment_mode" gorm:"not null;default:''"` // Boşsa COMMENT_MODERATION geçerlidir
+	Version       int        `json:"version" gorm:"not null;default:1"`
+	Reactions     []Reaction `gorm:"foreignKey:PostID" json:"reactions,omitempty"`
+	Comments      []Comment  `gorm:"foreignKey:PostID" json:"comments,omitempty"`
+	Categories    []Category `gorm:"many2many:post_categories"`
+	Tags          []Tag      `gorm:"many2many:post_tags"`
+}
+
+// IsValidPostStatus durumun PostStatuses içinde olup olmadığını döner.
+func IsValidPostStatus(status string) bool {
+	for _, candidate := range PostStatuses {
+		if candidate == status {
+			return true
+		}
+	}
+	return false
 }
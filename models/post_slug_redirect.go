@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// PostSlugRedirect bir postun daha önce kullandığı slug'dır. Bu slug'la gelen
+// istekler postun güncel adresine kalıcı olarak yönlendirilir.
+type PostSlugRedirect struct {
+	ID        uint   `gorm:"primaryKey"`
+	Slug      string `gorm:"uniqueIndex;not null"`
+	PostID    uint   `gorm:"index;not null"`
+	CreatedAt time.Time
+}
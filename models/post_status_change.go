@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SystemActorID kullanıcı yerine zamanlayıcının yaptığı durum değişikliklerini belirtir.
+var SystemActorID = uuid.Nil
+
+// PostStatusChange bir postun yayın akışındaki her durum değişikliğinin kaydıdır.
+type PostStatusChange struct {
+	ID         uint      `gorm:"primaryKey"`
+	PostID     uint      `gorm:"index;not null"`
+	FromStatus string    `gorm:"not null"`
+	ToStatus   string    `gorm:"not null"`
+	ActorID    uuid.UUID `gorm:"type:uuid;not null"` // Zamanlayıcı için SystemActorID
+	Note       string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCode authenticator cihazı kaybedildiğinde TOTP yerine kullanılabilen
+// tek kullanımlık koddur. Yalnızca SHA-256 özeti saklanır.
+type RecoveryCode struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	User      User      `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CodeHash  string    `gorm:"index;not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
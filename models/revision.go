@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostRevision bir postun kaydedilmiş her sürümüdür. Number post içinde 1'den
+// başlayarak artar; en yüksek numara postun mevcut halidir.
+type PostRevision struct {
+	ID         uint      `gorm:"primaryKey"`
+	PostID     uint      `gorm:"uniqueIndex:idx_post_revisions_number;not null"`
+	Number     int       `gorm:"uniqueIndex:idx_post_revisions_number;not null"`
+	Title      string    `gorm:"not null"`
+	Content    string    `gorm:"not null"`
+	Format     string    `gorm:"not null;default:markdown"`
+	EditorID   uuid.UUID `gorm:"type:uuid;not null"` // Sürümü kaydeden kullanıcı
+	ChangeNote string
+	CreatedAt  time.Time
+}
+
+// CommentRevision bir yorumun kaydedilmiş her sürümüdür.
+type CommentRevision struct {
+	ID         uint      `gorm:"primaryKey"`
+	CommentID  uint      `gorm:"uniqueIndex:idx_comment_revisions_number;not null"`
+	Number     int       `gorm:"uniqueIndex:idx_comment_revisions_number;not null"`
+	Content    string    `gorm:"not null"`
+	Format     string    `gorm:"not null;default:markdown"`
+	EditorID   uuid.UUID `gorm:"type:uuid;not null"`
+	ChangeNote string
+	CreatedAt  time.Time
+}
@@ -6,5 +6,6 @@ type Role struct {
 	gorm.Model
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	RequireMFA  bool   `json:"require_mfa" gorm:"not null;default:false"`
 	Users       []User `gorm:"many2many:user_roles;"`
 }
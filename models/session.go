@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session bir refresh token ailesini temsil eder. Aynı oturumdan üretilen
+// tüm refresh token'lar bu kayda bağlıdır; oturum iptal edildiğinde
+// (logout) ailenin tamamı ve ona bağlı access token'lar geçersiz olur.
+type Session struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;index;not null" json:"user_id"`
+	User       User       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// RefreshToken bir oturuma ait tek kullanımlık refresh token'dır.
+// Token'ın kendisi değil yalnızca SHA-256 özeti saklanır.
+type RefreshToken struct {
+	ID        uint      `gorm:"primaryKey"`
+	SessionID uuid.UUID `gorm:"type:uuid;index;not null"`
+	Session   Session   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
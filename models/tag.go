@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// Tag yazarların postlara serbestçe eklediği etikettir. Adlar küçük harfe
+// çevrilip boşlukları sadeleştirilerek saklanır; aynı slug'a inen adlar tek
+// bir etikettir.
+type Tag struct {
+	ID        uint   `gorm:"primaryKey" json:"id"`
+	Name      string `json:"name" gorm:"not null"`
+	Slug      string `json:"slug" gorm:"uniqueIndex;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
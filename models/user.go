@@ -1,18 +1,45 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
+const (
+	// DeletionModeAnonymize yazıları ve yorumları silinmiş kullanıcıya devreder
+	DeletionModeAnonymize = "anonymize"
+	// DeletionModeDelete yazıları ve yorumları kalıcı olarak siler
+	DeletionModeDelete = "delete"
+
+	DeletedUsername = "deleted"
+)
+
+// DeletedUserID anonimleştirilen içeriğin devredildiği yer tutucu kullanıcıdır.
+var DeletedUserID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
 type User struct {
-	ID        uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
-	FirstName string     `json:"first_name,omitempty"`
-	LastName  string     `json:"last_name,omitempty"`
-	Username  string     `json:"username"`
-	Email     string     `json:"email"`
-	Password  string     `json:"-"`
-	Posts     []Post     `gorm:"foreignKey:AuthorID" json:"-"`
-	Comments  []Comment  `gorm:"foreignKey:AuthorID" json:"-"`
-	Reactions []Reaction `gorm:"foreignKey:UserID" json:"-"`
-	Roles     []Role     `gorm:"many2many:user_roles;" json:"-"`
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	FirstName     string     `json:"first_name,omitempty"`
+	LastName      string     `json:"last_name,omitempty"`
+	Username      string     `json:"username" gorm:"uniqueIndex;not null"`
+	Email         string     `json:"email" gorm:"uniqueIndex;not null"`
+	Password      string     `json:"-"`
+	Bio           string     `json:"bio,omitempty"`
+	Website       string     `json:"website,omitempty"`
+	AvatarURL     string     `json:"avatar_url,omitempty"`
+	EmailVerified bool       `json:"email_verified" gorm:"not null;default:false"`
+	VerifiedAt    *time.Time `json:"verified_at,omitempty"`
+	TOTPSecret    string     `json:"-"`
+	TOTPEnabled   bool       `json:"totp_enabled" gorm:"not null;default:false"`
+	TOTPLastStep  int64      `json:"-"` // Aynı kodun tekrar kullanılmasını engeller
+	// Hesap silme talebi bekleme süresi dolunca jobs.PurgeDeletedAccounts tarafından tamamlanır
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty" gorm:"index"`
+	DeletionMode        string     `json:"deletion_mode,omitempty"`
+	// Kaydı spam şüphesiyle işaretlenen kullanıcının yorumları, bir moderatör birini onaylayana kadar beklemeye alınır
+	SpamFlaggedAt *time.Time `json:"-"`
+	Posts         []Post     `gorm:"foreignKey:AuthorID" json:"-"`
+	Comments      []Comment  `gorm:"foreignKey:AuthorID" json:"-"`
+	Reactions     []Reaction `gorm:"foreignKey:UserID" json:"-"`
+	Roles         []Role     `gorm:"many2many:user_roles;" json:"-"`
 }
@@ -0,0 +1,342 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// ErrNotConfigured OIDC_ISSUER_URL tanımlı değilken döner.
+var ErrNotConfigured = errors.New("OIDC login is not configured")
+
+// Discovery issuer'ın /.well-known/openid-configuration belgesindeki alanlardır.
+type Discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Claims ID token'dan okunan kullanıcı bilgileridir.
+type Claims struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	PreferredUsername string
+	GivenName         string
+	FamilyName        string
+	Groups            []string
+}
+
+// Provider tek bir OpenID Connect kimlik sağlayıcısı için authorization code + PKCE akışını yürütür.
+type Provider struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	GroupsClaim  string
+	// RoleMapping IdP grup adlarını models.Role adlarına eşler
+	RoleMapping map[string]string
+
+	client *http.Client
+
+	mu        sync.RWMutex
+	discovery *Discovery
+	keys      map[string]interface{}
+	keysAt    time.Time
+}
+
+// Default OIDC_ISSUER_URL tanımlıysa kullanılan sağlayıcıdır; aksi halde nil'dir.
+var Default *Provider
+
+// InitProvider sağlayıcıyı ortam değişkenlerinden yapılandırır. Discovery
+// başlangıçta başarısız olursa ilk girişte tekrar denenir.
+func InitProvider() {
+	issuer := os.Getenv("OIDC_ISSUER_URL")
+	if issuer == "" {
+		return
+	}
+
+	scopes := strings.Fields(os.Getenv("OIDC_SCOPES"))
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	groupsClaim := os.Getenv("OIDC_GROUPS_CLAIM")
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+
+	Default = &Provider{
+		IssuerURL:    strings.TrimSuffix(issuer, "/"),
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		Scopes:       scopes,
+		GroupsClaim:  groupsClaim,
+		RoleMapping:  parseRoleMapping(os.Getenv("OIDC_ROLE_MAPPING")),
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+
+	if _, err := Default.Discover(context.Background()); err != nil {
+		log.Printf("OIDC discovery failed, will retry on first login: %v", err)
+	}
+}
+
+// parseRoleMapping "grup=Rol,grup2=Rol2" biçimindeki eşlemeyi çözümler.
+func parseRoleMapping(value string) map[string]string {
+	mapping := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		group, role, ok := strings.Cut(pair, "=")
+		if ok && strings.TrimSpace(group) != "" && strings.TrimSpace(role) != "" {
+			mapping[strings.TrimSpace(group)] = strings.TrimSpace(role)
+		}
+	}
+	return mapping
+}
+
+// Discover issuer metadata'sını getirir ve önbelleğe alır.
+func (p *Provider) Discover(ctx context.Context) (*Discovery, error) {
+	p.mu.RLock()
+	discovery := p.discovery
+	p.mu.RUnlock()
+	if discovery != nil {
+		return discovery, nil
+	}
+
+	var doc Discovery
+	if err := p.getJSON(ctx, p.IssuerURL+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != p.IssuerURL {
+		return nil, fmt.Errorf("issuer mismatch: expected %s, got %s", p.IssuerURL, doc.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing required endpoints")
+	}
+
+	p.mu.Lock()
+	p.discovery = &doc
+	p.mu.Unlock()
+	return &doc, nil
+}
+
+// AuthCodeURL kullanıcının yönlendirileceği authorization adresini üretir.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	discovery, err := p.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.ClientID)
+	params.Set("redirect_uri", p.RedirectURL)
+	params.Set("scope", strings.Join(p.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", CodeChallenge(codeVerifier))
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return discovery.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// CodeChallenge PKCE S256 code_challenge değerini hesaplar.
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Exchange authorization code'u token endpoint'inde ID token ile değiştirir
+// ve ID token'ı doğrulayarak claim'leri döner.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Claims, error) {
+	discovery, err := p.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", p.ClientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned %d: %s", resp.StatusCode, body)
+	}
+
+	var tokenResponse struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return nil, err
+	}
+	if tokenResponse.IDToken == "" {
+		return nil, errors.New("token response does not contain an id_token")
+	}
+
+	return p.VerifyIDToken(ctx, tokenResponse.IDToken, nonce)
+}
+
+// VerifyIDToken imzayı issuer'ın JWKS'i ile doğrular; iss, aud, exp ve nonce değerlerini kontrol eder.
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*Claims, error) {
+	token, err := jwt.Parse(rawIDToken, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		default:
+			return nil, fmt.Errorf("unsupported signing method %s", token.Method.Alg())
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.verificationKey(ctx, kid)
+	})
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("invalid id_token: %v", err)
+	}
+
+	mapClaims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid id_token claims")
+	}
+
+	if iss, _ := mapClaims["iss"].(string); strings.TrimSuffix(iss, "/") != p.IssuerURL {
+		return nil, errors.New("id_token issuer mismatch")
+	}
+	if !audienceContains(mapClaims["aud"], p.ClientID) {
+		return nil, errors.New("id_token audience mismatch")
+	}
+	if _, hasExp := mapClaims["exp"]; !hasExp {
+		return nil, errors.New("id_token has no expiry")
+	}
+	if tokenNonce, _ := mapClaims["nonce"].(string); tokenNonce == "" || tokenNonce != nonce {
+		return nil, errors.New("id_token nonce mismatch")
+	}
+
+	claims := &Claims{Issuer: p.IssuerURL}
+	claims.Subject, _ = mapClaims["sub"].(string)
+	claims.Email, _ = mapClaims["email"].(string)
+	claims.PreferredUsername, _ = mapClaims["preferred_username"].(string)
+	claims.GivenName, _ = mapClaims["given_name"].(string)
+	claims.FamilyName, _ = mapClaims["family_name"].(string)
+	claims.Groups = stringSlice(mapClaims[p.GroupsClaim])
+
+	// Bazı sağlayıcılar email_verified değerini string olarak gönderir
+	switch verified := mapClaims["email_verified"].(type) {
+	case bool:
+		claims.EmailVerified = verified
+	case string:
+		claims.EmailVerified = verified == "true"
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("id_token has no subject")
+	}
+	return claims, nil
+}
+
+// MappedRoles kullanıcının gruplarına karşılık gelen rol adlarını döner.
+func (p *Provider) MappedRoles(groups []string) []string {
+	var roles []string
+	for _, group := range groups {
+		if role, ok := p.RoleMapping[group]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// ManagedRoles eşlemede geçen ve dolayısıyla IdP tarafından yönetilen rol adlarıdır.
+func (p *Provider) ManagedRoles() []string {
+	seen := map[string]bool{}
+	var roles []string
+	for _, role := range p.RoleMapping {
+		if !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func (p *Provider) getJSON(ctx context.Context, endpoint string, target interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned %d", endpoint, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(target)
+}
+
+func audienceContains(aud interface{}, clientID string) bool {
+	for _, value := range stringSlice(aud) {
+		if value == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+func stringSlice(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
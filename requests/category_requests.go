@@ -1,9 +1,21 @@
 package requests
 
 type CreateCategoryRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name        string `json:"name" binding:"required,max=100"`
+	Slug        string `json:"slug" binding:"omitempty,max=80"`
+	Description string `json:"description" binding:"max=1000"`
+	ParentID    *uint  `json:"parent_id" binding:"omitempty,min=1"`
 }
 
+// UpdateCategoryRequest kategoriyi tamamen değiştirir; parent_id gönderilmezse
+// kategori kök seviyeye taşınır, slug gönderilmezse mevcut slug korunur.
 type UpdateCategoryRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name        string `json:"name" binding:"required,max=100"`
+	Slug        string `json:"slug" binding:"omitempty,max=80"`
+	Description string `json:"description" binding:"max=1000"`
+	ParentID    *uint  `json:"parent_id" binding:"omitempty,min=1"`
+}
+
+type MergeCategoryRequest struct {
+	TargetID uint `json:"target_id" binding:"required,min=1"`
 }
@@ -2,10 +2,21 @@ package requests
 
 // CreateCommentRequest yeni yorum oluşturmak için model
 type CreateCommentRequest struct {
-	Content string `json:"content" binding:"required"`
+	Content string `json:"content" binding:"required,max=10000"`
+	// Varsayılan markdown
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=markdown plain html"`
+	// Formda gizlenen tuzak alanı; insanlar boş bırakır
+	Honeypot string `json:"honeypot"`
+	// Form açılırken GET /forms/token ile alınan token
+	FormToken string `json:"form_token"`
 }
 
 // UpdateCommentRequest yorumu güncellemek için model
 type UpdateCommentRequest struct {
-	Content string `json:"content" binding:"required"`
+	Content string `json:"content" binding:"required,max=10000"`
+	// Gönderilmezse mevcut biçim korunur
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=markdown plain html"`
+	ChangeNote    string `json:"change_note" binding:"max=500"`
+	// If-Match başlığı gönderilmezse zorunludur
+	Version *int `json:"version" binding:"omitempty,min=1"`
 }
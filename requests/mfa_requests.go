@@ -0,0 +1,15 @@
+package requests
+
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"` // TOTP veya kurtarma kodu
+}
+
+type DisableMFARequest struct {
+	Password string `json:"password"`                // Şifresi olmayan (yalnızca OIDC) hesaplarda gerekmez
+	Code     string `json:"code" binding:"required"` // TOTP veya kurtarma kodu
+}
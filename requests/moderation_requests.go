@@ -0,0 +1,14 @@
+package requests
+
+// ModerateCommentRequest tek bir yorumu onaylamak veya reddetmek için model
+type ModerateCommentRequest struct {
+	// Reddederken zorunludur; karar kaydında saklanır
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+// BulkModerateCommentsRequest birden fazla yorum için aynı kararı vermek için model
+type BulkModerateCommentsRequest struct {
+	CommentIDs []uint `json:"comment_ids" binding:"required,min=1,max=100,dive,min=1"`
+	Action     string `json:"action" binding:"required,oneof=approve reject"`
+	Reason     string `json:"reason" binding:"max=500"`
+}
@@ -1,11 +1,50 @@
 package requests
 
+import "time"
+
 type CreatePostRequest struct {
 	Title   string `json:"title" binding:"required"`
-	Content string `json:"content" binding:"required"`
+	Content string `json:"content" binding:"required,max=100000"`
+	// Verilmezse başlıktan üretilir
+	Slug string `json:"slug" binding:"max=80"`
+	// Varsayılan markdown
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=markdown plain html"`
+	CategoryIDs   []uint `json:"category_ids" binding:"omitempty,dive,min=1"`
+	// Bilinmeyen etiketler oluşturulur; büyük/küçük harf ve tekrarlar yok sayılır
+	Tags []string `json:"tags" binding:"omitempty,max=10,dive,max=50"`
+	// Varsayılan draft; in_review doğrudan incelemeye gönderir
+	Status string `json:"status" binding:"omitempty,oneof=draft in_review published"`
+	// Boşsa sitenin COMMENT_MODERATION ayarı geçerlidir
+	CommentMode string `json:"comment_mode" binding:"omitempty,oneof=open moderated closed"`
 }
 
 type UpdatePostRequest struct {
 	Title   string `json:"title" binding:"required"`
-	Content string `json:"content" binding:"required"`
+	Content string `json:"content" binding:"required,max=100000"`
+	// Gönderilmezse slug değişmez, boş değer başlıktan yeniden üretir; eski slug yönlendirme olarak kalır
+	Slug *string `json:"slug" binding:"omitempty,max=80"`
+	// Gönderilmezse mevcut biçim korunur
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=markdown plain html"`
+	// Gönderilmezse kategoriler değişmez, boş liste tüm kategorileri kaldırır
+	CategoryIDs *[]uint `json:"category_ids" binding:"omitempty,dive,min=1"`
+	// Gönderilmezse etiketler değişmez, boş liste tüm etiketleri kaldırır
+	Tags *[]string `json:"tags" binding:"omitempty,max=10,dive,max=50"`
+	// Gönderilmezse değişmez, boş değer sitenin COMMENT_MODERATION ayarına döner
+	CommentMode *string `json:"comment_mode" binding:"omitempty,oneof='' open moderated closed"`
+	// Başlık veya içerik değişirse oluşturulan sürüme eklenir
+	ChangeNote string `json:"change_note" binding:"max=500"`
+	// If-Match başlığı gönderilmezse zorunludur; GetPost yanıtındaki version değeri
+	Version *int `json:"version" binding:"omitempty,min=1"`
+}
+
+type ChangePostStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+	// Editör reddederken veya yayından kaldırırken zorunludur
+	Note string `json:"note" binding:"max=2000"`
+	// status=scheduled için zorunlu; post bu zamanda yayına girer
+	PublishAt *time.Time `json:"publish_at"`
+	// Verilirse post bu zamanda arşivlenir; verilmezse mevcut değer korunur
+	UnpublishAt *time.Time `json:"unpublish_at"`
+	// Mevcut unpublish_at değerini siler
+	ClearUnpublishAt bool `json:"clear_unpublish_at"`
 }
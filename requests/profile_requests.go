@@ -0,0 +1,20 @@
+package requests
+
+// UpdateProfileRequest yalnızca gönderilen alanları günceller.
+type UpdateProfileRequest struct {
+	FirstName *string `json:"first_name" binding:"omitempty,max=100"`
+	LastName  *string `json:"last_name" binding:"omitempty,max=100"`
+	Username  *string `json:"username" binding:"omitempty,min=3,max=30"`
+	Bio       *string `json:"bio" binding:"omitempty,max=500"`
+	Website   *string `json:"website" binding:"omitempty,max=255"` // Boş string alanı temizler
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=6"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password"`                                        // Şifresi olmayan (yalnızca OIDC) hesaplarda gerekmez
+	Mode     string `json:"mode" binding:"omitempty,oneof=anonymize delete"` // Varsayılan: anonymize
+}
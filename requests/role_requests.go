@@ -14,3 +14,7 @@ type RemoveRoleRequest struct {
 	UserID string `json:"user_id" binding:"required"`
 	RoleID uint   `json:"role_id" binding:"required"`
 }
+
+type SetRoleMFARequest struct {
+	RequireMFA *bool `json:"require_mfa" binding:"required"`
+}
@@ -0,0 +1,12 @@
+package requests
+
+// UpdateTagRequest etiketi yeniden adlandırır; slug gönderilmezse yeni addan üretilir.
+type UpdateTagRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+	Slug string `json:"slug" binding:"omitempty,max=80"`
+}
+
+type MergeTagRequest struct {
+	// Hedef etiketin slug'ı
+	Target string `json:"target" binding:"required,max=80"`
+}
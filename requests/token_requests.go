@@ -0,0 +1,7 @@
+package requests
+
+type CreatePersonalAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1"`                    // ör. "posts:write", "comments:read"
+	ExpiresInDays int      `json:"expires_in_days" binding:"omitempty,min=1,max=3650"` // Boş ise süresiz
+}
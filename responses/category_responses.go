@@ -1,10 +1,34 @@
 package responses
 
 type CategoryResponse struct {
+	ID          uint            `json:"id"`
+	Name        string          `json:"name"`
+	Slug        string          `json:"slug"`
+	Description string          `json:"description,omitempty"`
+	ParentID    *uint           `json:"parent_id,omitempty"`
+	Breadcrumb  []CategoryCrumb `json:"breadcrumb,omitempty"`
+}
+
+// CategoryCrumb kök kategoriden kategorinin kendisine uzanan yoldaki bir adımdır.
+type CategoryCrumb struct {
 	ID   uint   `json:"id"`
 	Name string `json:"name"`
+	Slug string `json:"slug"`
 }
 
 type CategoriesResponse struct {
 	Categories []CategoryResponse `json:"categories"`
 }
+
+type CategoryTreeNode struct {
+	ID          uint               `json:"id"`
+	Name        string             `json:"name"`
+	Slug        string             `json:"slug"`
+	Description string             `json:"description,omitempty"`
+	Children    []CategoryTreeNode `json:"children"`
+}
+
+type CategoryMergeResponse struct {
+	Target     CategoryResponse `json:"target"`
+	MovedPosts int64            `json:"moved_posts"`
+}
@@ -6,14 +6,25 @@ import (
 	"github.com/google/uuid"
 )
 
-// CommentResponse yorum bilgilerini temsil eden model
+// CommentResponse yorum bilgilerini temsil eden model. Silinmiş ama yanıtları
+// olan yorumlar deleted=true ve "[deleted]" içeriğiyle döner.
 type CommentResponse struct {
-	ID        uint      `json:"id"`
-	Content   string    `json:"content"`
-	AuthorID  uuid.UUID `json:"author_id"`
-	PostID    uint      `json:"post_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID             uint              `json:"id"`
+	Content        string            `json:"content"`
+	ContentFormat  string            `json:"content_format"`
+	ContentHTML    string            `json:"content_html"`
+	AuthorID       uuid.UUID         `json:"author_id"`
+	PostID         uint              `json:"post_id"`
+	ParentID       *uint             `json:"parent_id"`
+	Depth          int               `json:"depth"`
+	Deleted        bool              `json:"deleted"`
+	Status         string            `json:"status"`
+	ReplyCount     int64             `json:"reply_count"`
+	Replies        []CommentResponse `json:"replies,omitempty"`
+	HasMoreReplies bool              `json:"has_more_replies"`
+	Version        int               `json:"version"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
 }
 
 // CommentsResponse birden fazla yorumu temsil eden model
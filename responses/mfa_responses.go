@@ -0,0 +1,11 @@
+package responses
+
+type TOTPSetupResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+	QRCode     string `json:"qr_code"` // otpauth adresini içeren PNG, data URI olarak
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"` // Yalnızca bir kez gösterilir
+}
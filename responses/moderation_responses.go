@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentModerationResponse struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    uuid.UUID `json:"actor_id"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// BulkModerationSkip toplu işlemde karar verilemeyen yorumu ve nedenini taşır.
+type BulkModerationSkip struct {
+	CommentID uint   `json:"comment_id"`
+	Error     string `json:"error"`
+}
+
+type BulkModerationResponse struct {
+	Moderated []CommentResponse    `json:"moderated"`
+	Skipped   []BulkModerationSkip `json:"skipped"`
+}
@@ -7,14 +7,33 @@ import (
 )
 
 type PostResponse struct {
-	ID        uint      `json:"id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	AuthorID  uuid.UUID `json:"author_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            uint               `json:"id"`
+	Title         string             `json:"title"`
+	Slug          string             `json:"slug"`
+	Content       string             `json:"content"`
+	ContentFormat string             `json:"content_format"`
+	ContentHTML   string             `json:"content_html"`
+	AuthorID      uuid.UUID          `json:"author_id"`
+	Status        string             `json:"status"`
+	PublishedAt   *time.Time         `json:"published_at,omitempty"`
+	PublishAt     *time.Time         `json:"publish_at,omitempty"`
+	UnpublishAt   *time.Time         `json:"unpublish_at,omitempty"`
+	Categories    []CategoryResponse `json:"categories"`
+	Tags          []TagResponse      `json:"tags"`
+	CommentMode   string             `json:"comment_mode"`
+	Version       int                `json:"version"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
 }
 
 type PostsResponse struct {
 	Posts []PostResponse `json:"posts"`
 }
+
+type PostStatusChangeResponse struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    uuid.UUID `json:"actor_id"`
+	Note       string    `json:"note,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,64 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileResponse giriş yapmış kullanıcının kendi profilidir.
+type ProfileResponse struct {
+	ID            uuid.UUID `json:"id"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"email_verified"`
+	TOTPEnabled   bool      `json:"totp_enabled"`
+	Bio           string    `json:"bio"`
+	Website       string    `json:"website"`
+	AvatarURL     string    `json:"avatar_url"`
+	Roles         []string  `json:"roles"`
+
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	DeletionMode        string     `json:"deletion_mode,omitempty"`
+}
+
+// PublicProfileResponse herkese açık yazar profilidir; email gibi kişisel
+// bilgiler içermez.
+type PublicProfileResponse struct {
+	ID           uuid.UUID      `json:"id"`
+	Username     string         `json:"username"`
+	FirstName    string         `json:"first_name"`
+	LastName     string         `json:"last_name"`
+	Bio          string         `json:"bio"`
+	Website      string         `json:"website"`
+	AvatarURL    string         `json:"avatar_url"`
+	PostCount    int64          `json:"post_count"`
+	CommentCount int64          `json:"comment_count"`
+	Posts        []PostResponse `json:"posts"`
+}
+
+type AccountDeletionResponse struct {
+	ScheduledAt time.Time `json:"scheduled_at"` // Bu zamana kadar /users/me/deletion/cancel ile iptal edilebilir
+	Mode        string    `json:"mode"`
+}
+
+// DataExportResponse kullanıcının kişisel verilerinin dökümüdür.
+type DataExportResponse struct {
+	ExportedAt time.Time          `json:"exported_at"`
+	Profile    ProfileResponse    `json:"profile"`
+	Posts      []PostResponse     `json:"posts"`
+	Comments   []CommentResponse  `json:"comments"`
+	Reactions  []ReactionResponse `json:"reactions"`
+	Sessions   []SessionExport    `json:"sessions"`
+}
+
+type SessionExport struct {
+	ID         uuid.UUID  `json:"id"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
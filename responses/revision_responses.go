@@ -0,0 +1,50 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostRevisionResponse struct {
+	Number        int       `json:"number"`
+	Title         string    `json:"title"`
+	Content       string    `json:"content"`
+	ContentFormat string    `json:"content_format"`
+	EditorID      uuid.UUID `json:"editor_id"`
+	ChangeNote    string    `json:"change_note,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type PostRevisionsResponse struct {
+	Revisions []PostRevisionResponse `json:"revisions"`
+}
+
+type CommentRevisionResponse struct {
+	Number        int       `json:"number"`
+	Content       string    `json:"content"`
+	ContentFormat string    `json:"content_format"`
+	EditorID      uuid.UUID `json:"editor_id"`
+	ChangeNote    string    `json:"change_note,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type CommentRevisionsResponse struct {
+	Revisions []CommentRevisionResponse `json:"revisions"`
+}
+
+// DiffOp farkın bir parçasıdır; type equal, insert veya delete olabilir.
+type DiffOp struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// RevisionDiffResponse iki sürüm arasındaki farktır. Başlık farkı yalnızca
+// postlar için doludur.
+type RevisionDiffResponse struct {
+	From    int      `json:"from"`
+	To      int      `json:"to"`
+	Mode    string   `json:"mode"`
+	Title   []DiffOp `json:"title,omitempty"`
+	Content []DiffOp `json:"content"`
+}
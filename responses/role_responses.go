@@ -4,4 +4,5 @@ type RoleResponse struct {
 	ID          uint   `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	RequireMFA  bool   `json:"require_mfa"`
 }
@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SearchResultResponse struct {
+	Type      string    `json:"type"` // post veya comment
+	ID        uint      `json:"id"`
+	PostID    uint      `json:"post_id"`
+	PostSlug  string    `json:"post_slug"`
+	PostTitle string    `json:"post_title"`
+	AuthorID  uuid.UUID `json:"author_id"`
+	Snippet   string    `json:"snippet"` // Eşleşmeler <mark> ile işaretlenmiş HTML
+	Rank      float64   `json:"rank"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SearchResponse struct {
+	Results []SearchResultResponse `json:"results"`
+}
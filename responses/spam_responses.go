@@ -0,0 +1,8 @@
+package responses
+
+import "time"
+
+type FormTokenResponse struct {
+	Token     string    `json:"token"` // Kayıt ve yorum formlarında form_token olarak geri gönderilir
+	ExpiresAt time.Time `json:"expires_at"`
+}
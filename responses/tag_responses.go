@@ -0,0 +1,20 @@
+package responses
+
+type TagResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// TagUsageResponse etiket bulutu için etiketi kullanan yayımlanmış post sayısını taşır.
+type TagUsageResponse struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	PostCount int64  `json:"post_count"`
+}
+
+type TagMergeResponse struct {
+	Target     TagResponse `json:"target"`
+	MovedPosts int64       `json:"moved_posts"`
+}
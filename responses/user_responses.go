@@ -3,9 +3,10 @@ package responses
 import "github.com/google/uuid"
 
 type UserResponse struct {
-	ID       uuid.UUID `json:"id"`
-	Username string    `json:"username"`
-	Email    string    `json:"email,omitempty"` // Boş ise gösterilmez
+	ID            uuid.UUID `json:"id"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email,omitempty"` // Boş ise gösterilmez
+	EmailVerified bool      `json:"email_verified"`
 }
 
 type RegisterResponse struct {
@@ -14,6 +15,14 @@ type RegisterResponse struct {
 }
 
 type LoginResponse struct {
-	Message string `json:"message"`
-	Token   string `json:"token"`
+	Message      string `json:"message"`
+	Token        string `json:"token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"` // Access token'ın saniye cinsinden ömrü
+
+	// İki adımlı doğrulama etkinse token alanları boş döner, giriş mfa_token ile tamamlanır
+	MFARequired bool   `json:"mfa_required,omitempty"`
+	MFAToken    string `json:"mfa_token,omitempty"`
+	// Rolü 2FA gerektiren ancak TOTP kurmamış kullanıcılar yalnızca kurulum uçlarına erişebilir
+	MFAEnrollmentRequired bool `json:"mfa_enrollment_required,omitempty"`
 }
@@ -4,6 +4,8 @@ import (
 	"blog-platform/controllers"
 	_ "blog-platform/docs"
 	"blog-platform/middleware"
+	"blog-platform/utils"
+	"log"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -13,48 +15,155 @@ import (
 
 func SetupRouter() *gin.Engine {
 	router := gin.Default()
+	// c.ClientIP() giriş kısıtlaması, spam kontrolleri ve denetim kayıtlarında
+	// kullanıldığından X-Forwarded-For yalnızca tanımlı vekil sunuculardan kabul edilir
+	if err := router.SetTrustedProxies(utils.GetEnvList("TRUSTED_PROXIES")); err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	router.GET("/.well-known/jwks.json", controllers.GetJWKS)
+	router.GET("/search", controllers.Search)
+	router.GET("/forms/token", controllers.GetFormToken)
+
+	// Besleme okuyucuları kimlik doğrulaması yapmadığından beslemeler grupların
+	// kimlik doğrulama ara katmanlarının dışında kaydedilir
+	for _, prefix := range []string{"", "/users/:username", "/category/:category_id", "/tags/:slug"} {
+		router.GET(prefix+"/feed.xml", controllers.GetRSSFeed)
+		router.GET(prefix+"/atom.xml", controllers.GetAtomFeed)
+		router.GET(prefix+"/feed.json", controllers.GetJSONFeed)
+	}
+	router.Static("/uploads", utils.UploadDir())
+
+	authRoutes := router.Group("/auth")
+	{
+		authRoutes.GET("/oidc/login", controllers.OIDCLogin)
+		authRoutes.GET("/oidc/callback", controllers.OIDCCallback)
+	}
 
 	userRoutes := router.Group("/users")
 	{
 		userRoutes.POST("/register", controllers.RegisterUser)
 		userRoutes.POST("/login", controllers.LoginUser)
+		userRoutes.POST("/login/mfa", controllers.VerifyMFALogin)
+		userRoutes.POST("/refresh", controllers.RefreshToken)
+		userRoutes.POST("/password/forgot", controllers.ForgotPassword)
+		userRoutes.POST("/password/reset", controllers.ResetPassword)
+		userRoutes.GET("/verify", controllers.VerifyEmail)
+		userRoutes.POST("/verify/resend", middleware.EnrollmentAuthMiddleware(), controllers.ResendVerificationEmail)
+		userRoutes.POST("/logout", middleware.EnrollmentAuthMiddleware(), controllers.LogoutUser)
+		userRoutes.POST("/logout/all", middleware.EnrollmentAuthMiddleware(), controllers.LogoutAllSessions)
+		userRoutes.GET("/:username", controllers.GetPublicProfile)
+	}
+
+	profileRoutes := router.Group("/users/me")
+	{
+		profileAuth := middleware.ScopedAuthMiddleware("user")
+		profileRoutes.GET("", profileAuth, controllers.GetMyProfile)
+		profileRoutes.PATCH("", profileAuth, controllers.UpdateMyProfile)
+		profileRoutes.POST("/avatar", profileAuth, controllers.UploadAvatar)
+		profileRoutes.POST("/password", middleware.AuthMiddleware(), controllers.ChangePassword)
+		profileRoutes.GET("/export", middleware.AuthMiddleware(), controllers.ExportMyData)
+		profileRoutes.DELETE("", middleware.AuthMiddleware(), controllers.RequestAccountDeletion)
+		profileRoutes.POST("/deletion/cancel", middleware.EnrollmentAuthMiddleware(), controllers.CancelAccountDeletion)
+	}
+
+	mfaRoutes := router.Group("/users/me/mfa")
+	mfaRoutes.Use(middleware.EnrollmentAuthMiddleware())
+	{
+		mfaRoutes.POST("/totp/setup", controllers.SetupTOTP)
+		mfaRoutes.POST("/totp/confirm", controllers.ConfirmTOTP)
+		mfaRoutes.DELETE("/totp", controllers.DisableTOTP)
+		mfaRoutes.POST("/recovery-codes", controllers.RegenerateRecoveryCodes)
+	}
+
+	// Token yönetimi yalnızca oturum JWT'si ile yapılabilir; bir token başka token üretemez
+	tokenRoutes := router.Group("/users/me/tokens")
+	tokenRoutes.Use(middleware.AuthMiddleware())
+	{
+		tokenRoutes.POST("", controllers.CreatePersonalAccessToken)
+		tokenRoutes.GET("", controllers.GetPersonalAccessTokens)
+		tokenRoutes.DELETE("/:token_id", controllers.RevokePersonalAccessToken)
 	}
 
 	postRoutes := router.Group("/posts")
 
-	postRoutes.GET("/", controllers.GetPosts)
-	postRoutes.GET("/:post_id", controllers.GetPost)
+	// Anonim istekler yalnızca yayımlanmış postları görür
+	optionalPostAuth := middleware.OptionalScopedAuthMiddleware("posts")
+	postRoutes.GET("/", optionalPostAuth, controllers.GetPosts)
+	postRoutes.GET("/:post_id", optionalPostAuth, controllers.GetPost)
+	postRoutes.GET("/by-slug/:slug", optionalPostAuth, controllers.GetPostBySlug)
 
-	postRoutes.POST("/", middleware.AuthMiddleware(), controllers.CreatePost)
-	postRoutes.PUT("/:post_id", middleware.AuthMiddleware(), controllers.UpdatePost)
-	postRoutes.DELETE("/:post_id", middleware.AuthMiddleware(), controllers.RemovePost)
+	postAuth := middleware.ScopedAuthMiddleware("posts")
+	postRoutes.GET("/mine", postAuth, controllers.GetMyPosts)
+	postRoutes.POST("/", postAuth, middleware.RequireVerifiedEmail(), controllers.CreatePost)
+	postRoutes.PUT("/:post_id", postAuth, controllers.UpdatePost)
+	postRoutes.DELETE("/:post_id", postAuth, controllers.RemovePost)
+	postRoutes.POST("/:post_id/status", postAuth, controllers.ChangePostStatus)
+	postRoutes.GET("/:post_id/status-history", postAuth, controllers.GetPostStatusHistory)
+	postRoutes.GET("/:post_id/revisions", postAuth, controllers.GetPostRevisions)
+	postRoutes.GET("/:post_id/revisions/diff", postAuth, controllers.GetPostRevisionDiff)
+	postRoutes.GET("/:post_id/revisions/:revision", postAuth, controllers.GetPostRevision)
+	postRoutes.POST("/:post_id/revisions/:revision/restore", postAuth, controllers.RestorePostRevision)
 
 	commentRoutes := router.Group("/comments")
-	commentRoutes.Use(middleware.AuthMiddleware())
+	commentRoutes.Use(middleware.ScopedAuthMiddleware("comments"))
 	{
 		commentRoutes.GET("/user", controllers.GetCommentsByUser)
-		commentRoutes.POST("/:post_id", controllers.CreateComment)
+		commentRoutes.POST("/:post_id", middleware.RequireVerifiedEmail(), controllers.CreateComment)
 		commentRoutes.GET("/post/:post_id", controllers.GetCommentsByPost)
 		commentRoutes.PUT("/:comment_id", controllers.UpdateComment)
 		commentRoutes.DELETE("/:comment_id", controllers.RemoveComment)
+		commentRoutes.GET("/:comment_id/replies", controllers.GetCommentReplies)
+		commentRoutes.GET("/:comment_id/revisions", controllers.GetCommentRevisions)
+		commentRoutes.GET("/:comment_id/revisions/diff", controllers.GetCommentRevisionDiff)
+		// Gin aynı konumdaki POST parametrelerinin aynı adı taşımasını istediğinden
+		// (POST /:post_id yorum oluşturur) parametre burada comment_id olarak kopyalanır
+		commentRoutes.POST("/:post_id/revisions/:revision/restore", aliasParam("post_id", "comment_id"), controllers.RestoreCommentRevision)
+		commentRoutes.POST("/:post_id/reply", middleware.RequireVerifiedEmail(), aliasParam("post_id", "comment_id"), controllers.ReplyToComment)
+	}
+
+	moderationRoutes := router.Group("/moderation")
+	moderationRoutes.Use(middleware.ScopedAuthMiddleware("comments"))
+	{
+		moderationRoutes.GET("/comments", controllers.GetModerationQueue)
+		moderationRoutes.POST("/comments/bulk", controllers.BulkModerateComments)
+		moderationRoutes.POST("/comments/:comment_id/approve", controllers.ApproveComment)
+		moderationRoutes.POST("/comments/:comment_id/reject", controllers.RejectComment)
+		moderationRoutes.GET("/comments/:comment_id/history", controllers.GetCommentModerationHistory)
 	}
 
 	reactionRoutes := router.Group("/reactions")
-	reactionRoutes.Use(middleware.AuthMiddleware())
+	reactionRoutes.Use(middleware.ScopedAuthMiddleware("reactions"))
 	{
-		reactionRoutes.POST("/", controllers.AddReaction)
+		reactionRoutes.POST("/", middleware.RequireVerifiedEmail(), controllers.AddReaction)
 		reactionRoutes.GET("/post/:post_id", controllers.GetReactionsByPost)
 		reactionRoutes.GET("/comment/:comment_id", controllers.GetReactionsByComment)
 		reactionRoutes.DELETE("/:reaction_id", controllers.RemoveReaction)
 	}
 
 	categoryRoutes := router.Group("/category")
-	categoryRoutes.Use(middleware.AuthMiddleware())
+	categoryRoutes.Use(middleware.ScopedAuthMiddleware("categories"))
 	{
 		categoryRoutes.GET("/", controllers.GetCategories)
-		categoryRoutes.POST("/", controllers.CreateCategory)
+		categoryRoutes.GET("/tree", controllers.GetCategoryTree)
 		categoryRoutes.GET("/:category_id", controllers.GetCategory)
+		categoryRoutes.GET("/:category_id/posts", controllers.GetCategoryPosts)
+
+		categoryEditors := categoryRoutes.Group("", middleware.RequireAnyRole("Editor", "Admin"))
+		categoryEditors.POST("/", controllers.CreateCategory)
+		categoryEditors.PUT("/:category_id", controllers.UpdateCategory)
+		categoryEditors.DELETE("/:category_id", controllers.DeleteCategory)
+		categoryEditors.POST("/:category_id/merge", controllers.MergeCategory)
+	}
+
+	tagRoutes := router.Group("/tags")
+	{
+		tagRoutes.GET("/", controllers.GetTags)
+		tagRoutes.GET("/:slug/posts", optionalPostAuth, controllers.GetTagPosts)
+
+		tagEditors := tagRoutes.Group("", middleware.ScopedAuthMiddleware("posts"), middleware.RequireAnyRole("Editor", "Admin"))
+		tagEditors.PUT("/:slug", controllers.UpdateTag)
+		tagEditors.POST("/:slug/merge", controllers.MergeTag)
 	}
 
 	adminRoutes := router.Group("/admin")
@@ -65,7 +174,17 @@ func SetupRouter() *gin.Engine {
 		adminRoutes.POST("/role/create", controllers.CreateRole)
 		adminRoutes.DELETE("/role/remove/:role_id", controllers.RemoveRole)
 		adminRoutes.POST("/role/remove-from-user", controllers.RemoveRoleFromUser)
+		adminRoutes.PUT("/role/:role_id/mfa", controllers.SetRoleMFARequirement)
+		adminRoutes.POST("/users/:user_id/unlock", controllers.UnlockUser)
 	}
 
 	return router
 }
+
+// aliasParam name yol parametresinin değerini alias adıyla da erişilebilir kılar.
+func aliasParam(name, alias string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Params = append(c.Params, gin.Param{Key: alias, Value: c.Param(name)})
+		c.Next()
+	}
+}
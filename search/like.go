@@ -0,0 +1,86 @@
+package search
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// searchDocument search_documents tablosunun satırıdır. SearchTitle ve
+// SearchBody, searchText ile sadeleştirilmiş metni tutar.
+type searchDocument struct {
+	ID          uint   `gorm:"primaryKey"`
+	Kind        string `gorm:"uniqueIndex:idx_search_documents_ref;not null"`
+	RefID       uint   `gorm:"uniqueIndex:idx_search_documents_ref;not null"`
+	PostID      uint   `gorm:"index;not null"`
+	Title       string `gorm:"not null;default:''"`
+	Body        string `gorm:"not null;default:''"`
+	SearchTitle string `gorm:"not null;default:''"`
+	SearchBody  string `gorm:"not null;default:''"`
+}
+
+func (searchDocument) TableName() string {
+	return "search_documents"
+}
+
+func newSearchDocument(doc Document) searchDocument {
+	return searchDocument{
+		Kind:        doc.Kind,
+		RefID:       doc.RefID,
+		PostID:      doc.PostID,
+		Title:       doc.Title,
+		Body:        doc.Body,
+		SearchTitle: searchText(doc.Title),
+		SearchBody:  searchText(doc.Body),
+	}
+}
+
+// upsertDocument belgeyi (kind, ref_id) çakışmasında güncelleyerek yazar.
+func upsertDocument(tx *gorm.DB, doc Document) error {
+	row := newSearchDocument(doc)
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "kind"}, {Name: "ref_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"post_id", "title", "body", "search_title", "search_body"}),
+	}).Create(&row).Error
+}
+
+// likeEngine tam metin desteği olmayan veritabanları için LIKE ile kelime
+// başı eşleşmesi yapar. Başlıkta geçen terimler gövdedekilerin iki katı puan alır.
+type likeEngine struct{}
+
+func (likeEngine) Name() string  { return "like" }
+func (likeEngine) Table() string { return "search_documents" }
+
+func (likeEngine) Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&searchDocument{})
+}
+
+func (likeEngine) Upsert(tx *gorm.DB, doc Document) error {
+	return upsertDocument(tx, doc)
+}
+
+func (likeEngine) Match(query *gorm.DB, terms []string) *gorm.DB {
+	for _, term := range terms {
+		pattern := likePattern(term)
+		query = query.Where("(search_documents.search_title LIKE ? OR search_documents.search_body LIKE ?)", pattern, pattern)
+	}
+	return query
+}
+
+func (likeEngine) Rank(terms []string) (string, []interface{}) {
+	parts := make([]string, 0, len(terms))
+	args := make([]interface{}, 0, len(terms)*2)
+	for _, term := range terms {
+		pattern := likePattern(term)
+		parts = append(parts, "(CASE WHEN search_documents.search_title LIKE ? THEN 2 ELSE 0 END + CASE WHEN search_documents.search_body LIKE ? THEN 1 ELSE 0 END)")
+		args = append(args, pattern, pattern)
+	}
+	return strings.Join(parts, " + "), args
+}
+
+// likePattern terimle başlayan kelimeleri bulan kalıbı döner. Terimler
+// yalnızca harf ve rakamdan oluştuğu için kaçışlama gerekmez.
+func likePattern(term string) string {
+	return "% " + term + "%"
+}
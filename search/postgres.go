@@ -0,0 +1,57 @@
+package search
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// postgresEngine sadeleştirilmiş başlık ve gövdeden üretilen bir tsvector
+// sütununu GIN indeksiyle arar. Başlık A, gövde B ağırlığı alır; 'simple'
+// yapılandırması dile özgü kök bulma yapmaz, böylece Türkçe ve İngilizce
+// içerik aynı şekilde eşleşir.
+type postgresEngine struct{}
+
+func (postgresEngine) Name() string  { return "postgres-tsvector" }
+func (postgresEngine) Table() string { return "search_documents" }
+
+func (postgresEngine) Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&searchDocument{}); err != nil {
+		return err
+	}
+	statements := []string{
+		`ALTER TABLE search_documents ADD COLUMN IF NOT EXISTS document tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('simple', search_title), 'A') ||
+				setweight(to_tsvector('simple', search_body), 'B')
+			) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_search_documents_document ON search_documents USING GIN (document)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (postgresEngine) Upsert(tx *gorm.DB, doc Document) error {
+	return upsertDocument(tx, doc)
+}
+
+func (postgresEngine) Match(query *gorm.DB, terms []string) *gorm.DB {
+	return query.Where("search_documents.document @@ to_tsquery('simple', ?)", tsQuery(terms))
+}
+
+func (postgresEngine) Rank(terms []string) (string, []interface{}) {
+	return "ts_rank(search_documents.document, to_tsquery('simple', ?))", []interface{}{tsQuery(terms)}
+}
+
+// tsQuery terimleri önek eşleşmesiyle AND'leyen bir sorguya çevirir: "go:* & orm:*".
+func tsQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term + ":*"
+	}
+	return strings.Join(parts, " & ")
+}
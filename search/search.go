@@ -0,0 +1,413 @@
+// Package search yayımlanmış postlarda ve yorumlarında tam metin arama yapar.
+// Her veritabanı sürücüsü kendi Engine uygulamasını kullanır: PostgreSQL'de
+// tsvector, sqlite_fts5 etiketiyle derlenmiş SQLite'ta FTS5, diğer durumlarda
+// LIKE tabanlı basit bir arama. İndeks post ve yorum yazılırken aynı
+// transaction içinde güncellenir.
+package search
+
+import (
+	"blog-platform/markup"
+	"blog-platform/models"
+	"blog-platform/utils"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	KindPost    = "post"
+	KindComment = "comment"
+
+	// maxTerms bir sorguda dikkate alınan en fazla kelime sayısıdır.
+	maxTerms = 10
+)
+
+// ErrEmptyQuery sorguda aranabilir bir kelime yoksa döner.
+var ErrEmptyQuery = errors.New("q must contain at least one letter or digit")
+
+// Document indekslenen bir post veya yorumdur. Title ve Body görüntülenen
+// metindir; eşleştirme Fold ile sadeleştirilmiş kopyaları üzerinden yapılır.
+type Document struct {
+	Kind   string
+	RefID  uint
+	PostID uint
+	Title  string
+	Body   string
+}
+
+// Engine bir veritabanı sürücüsüne özgü arama uygulamasıdır. Uygulamalar
+// tabloyu Table adıyla oluşturur; birleştirme ve filtreler ortak koddadır.
+type Engine interface {
+	Name() string
+	Table() string
+	// Migrate arama tablosunu ve indeksini oluşturur.
+	Migrate(db *gorm.DB) error
+	// Upsert belgeyi ekler veya aynı tür ve ID'li belgeyi değiştirir.
+	Upsert(tx *gorm.DB, doc Document) error
+	// Match sorguyu sadeleştirilmiş terimlerin hepsini içeren belgelerle sınırlar.
+	Match(query *gorm.DB, terms []string) *gorm.DB
+	// Rank alaka puanını hesaplayan SQL ifadesini döner; büyük değer daha alakalıdır.
+	Rank(terms []string) (string, []interface{})
+}
+
+// Query arama parametreleridir. Boş alanlar filtre uygulanmadığı anlamına gelir.
+type Query struct {
+	Text        string
+	Kind        string
+	AuthorID    *uuid.UUID
+	CategoryIDs []uint
+	From        *time.Time // Dahil alt sınır
+	To          *time.Time // Dahil üst sınır
+	Until       *time.Time // Hariç üst sınır; yalnızca tarih verilen filtreler için
+	Offset      int
+	Limit       int
+}
+
+// Result bir arama sonucudur. Snippet eşleşen kelimeleri <mark> ile
+// işaretlenmiş, kaçışlanmış HTML'dir.
+type Result struct {
+	Kind      string
+	ID        uint
+	PostID    uint
+	PostSlug  string
+	PostTitle string
+	AuthorID  uuid.UUID
+	Snippet   string
+	Rank      float64
+	CreatedAt time.Time
+}
+
+var engine Engine = likeEngine{}
+
+// Init sürücüye uygun arama motorunu seçer, tablosunu oluşturur ve indeks
+// boşsa mevcut postlar ile yorumlardan yeniden kurar.
+func Init(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "postgres":
+		engine = postgresEngine{}
+	case "sqlite":
+		engine = newSQLiteEngine()
+	default:
+		engine = likeEngine{}
+	}
+
+	if err := engine.Migrate(db); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := db.Table(engine.Table()).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		if err := Rebuild(db); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Search engine: %s", engine.Name())
+	return nil
+}
+
+// Rebuild indeksi silinmemiş tüm postlardan ve yorum ağacında görünen
+// yorumlardan yeniden oluşturur.
+func Rebuild(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM " + engine.Table()).Error; err != nil {
+			return err
+		}
+
+		var posts []models.Post
+		err := tx.Select("id", "title", "content", "content_html").FindInBatches(&posts, 200, func(batch *gorm.DB, _ int) error {
+			for _, post := range posts {
+				if err := engine.Upsert(tx, PostDocument(post)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// Derinlik derinlik ilerlenir ki atalar yanıtlarından önce işlensin;
+		// onaylanmamış bir atanın altındaki yanıtlar ağaçta görünmez
+		visible := make(map[uint]bool)
+		for depth := 0; ; depth++ {
+			found := false
+			var comments []models.Comment
+			err := tx.Select("id", "post_id", "parent_id", "content", "content_html", "removed_at").
+				Where("depth = ? AND status = ?", depth, models.CommentStatusApproved).
+				FindInBatches(&comments, 200, func(batch *gorm.DB, _ int) error {
+					found = true
+					for _, comment := range comments {
+						if comment.ParentID != nil && !visible[*comment.ParentID] {
+							continue
+						}
+						visible[comment.ID] = true
+						if comment.RemovedAt != nil {
+							continue
+						}
+						if err := engine.Upsert(tx, CommentDocument(comment)); err != nil {
+							return err
+						}
+					}
+					return nil
+				}).Error
+			if err != nil || !found {
+				return err
+			}
+		}
+	})
+}
+
+// PostDocument postun indekslenecek halini üretir. Gövde olarak biçimlendirme
+// işaretleri olmadan görüntülenen metin kullanılır.
+func PostDocument(post models.Post) Document {
+	return Document{Kind: KindPost, RefID: post.ID, PostID: post.ID, Title: post.Title, Body: bodyText(post.ContentHTML, post.Content)}
+}
+
+// CommentDocument yorumun indekslenecek halini üretir.
+func CommentDocument(comment models.Comment) Document {
+	return Document{Kind: KindComment, RefID: comment.ID, PostID: comment.PostID, Body: bodyText(comment.ContentHTML, comment.Content)}
+}
+
+func bodyText(rendered, content string) string {
+	if rendered == "" {
+		return content
+	}
+	return markup.PlainText(rendered)
+}
+
+// IndexPost postu indekse ekler veya günceller.
+func IndexPost(tx *gorm.DB, post models.Post) error {
+	return engine.Upsert(tx, PostDocument(post))
+}
+
+// IndexComment yorumu indekse ekler veya günceller. Onaylanmamış yorumlar ve
+// ataları onaylanmadığı için ağaçta gizlenen yanıtlar aranamaz; indekste
+// varsa silinir.
+func IndexComment(tx *gorm.DB, comment models.Comment) error {
+	visible, err := commentVisible(tx, comment)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return RemoveComments(tx, comment.ID)
+	}
+	return engine.Upsert(tx, CommentDocument(comment))
+}
+
+// IndexReplies yorumun durumu değiştiğinde altındaki yanıtları indekste
+// yorumun görünürlüğüne göre ekler veya siler.
+func IndexReplies(tx *gorm.DB, comment models.Comment) error {
+	visible, err := commentVisible(tx, comment)
+	if err != nil {
+		return err
+	}
+
+	shown := make(map[uint]bool)
+	shown[comment.ID] = visible
+	frontier := []uint{comment.ID}
+	for len(frontier) > 0 {
+		var replies []models.Comment
+		err := tx.Select("id", "post_id", "parent_id", "status", "content", "content_html", "removed_at").
+			Where("parent_id IN ?", frontier).Find(&replies).Error
+		if err != nil {
+			return err
+		}
+
+		frontier = frontier[:0]
+		var hidden []uint
+		for _, reply := range replies {
+			frontier = append(frontier, reply.ID)
+			shown[reply.ID] = shown[*reply.ParentID] && reply.Status == models.CommentStatusApproved
+			if !shown[reply.ID] || reply.RemovedAt != nil {
+				hidden = append(hidden, reply.ID)
+				continue
+			}
+			if err := engine.Upsert(tx, CommentDocument(reply)); err != nil {
+				return err
+			}
+		}
+		if err := RemoveComments(tx, hidden...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commentVisible yorumun ve tüm atalarının onaylı olup olmadığını döner;
+// yorum ağacı yalnızca bu yorumları gösterir.
+func commentVisible(tx *gorm.DB, comment models.Comment) (bool, error) {
+	if comment.Status != models.CommentStatusApproved {
+		return false, nil
+	}
+	for parentID := comment.ParentID; parentID != nil; {
+		var parent models.Comment
+		err := tx.Select("id", "parent_id", "status").Where("id = ?", *parentID).Take(&parent).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if parent.Status != models.CommentStatusApproved {
+			return false, nil
+		}
+		parentID = parent.ParentID
+	}
+	return true, nil
+}
+
+// RemovePosts postları ve yorumlarını indeksten siler.
+func RemovePosts(tx *gorm.DB, postIDs ...uint) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return tx.Exec("DELETE FROM "+engine.Table()+" WHERE post_id IN ?", postIDs).Error
+}
+
+// RemoveComments yorumları indeksten siler.
+func RemoveComments(tx *gorm.DB, commentIDs ...uint) error {
+	if len(commentIDs) == 0 {
+		return nil
+	}
+	return tx.Exec("DELETE FROM "+engine.Table()+" WHERE kind = ? AND ref_id IN ?", KindComment, commentIDs).Error
+}
+
+// Fold metni eşleştirme için sadeleştirir: küçük harfe çevirir ve Türkçe
+// dahil Latin harflerini ASCII karşılıklarına indirger; "Işık" → "isik".
+func Fold(value string) string {
+	return utils.Transliterate(strings.ToLower(value))
+}
+
+// words metni sadeleştirip harf ve rakam gruplarına böler.
+func words(text string) []string {
+	return strings.FieldsFunc(Fold(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// searchText indekslenecek metni sadeleştirilmiş kelimelerin boşlukla
+// birleştirilmiş haline çevirir. Baştaki ve sondaki boşluk, LIKE motorunun
+// kelime başı eşleşmesini "% terim%" kalıbıyla yapabilmesi içindir.
+func searchText(text string) string {
+	return " " + strings.Join(words(text), " ") + " "
+}
+
+// Terms sorgudaki harf ve rakam gruplarını sadeleştirilmiş olarak döner.
+func Terms(text string) []string {
+	fields := words(text)
+
+	seen := map[string]bool{}
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if seen[field] {
+			continue
+		}
+		seen[field] = true
+		terms = append(terms, field)
+		if len(terms) == maxTerms {
+			break
+		}
+	}
+	return terms
+}
+
+type resultRow struct {
+	Kind             string
+	RefID            uint
+	PostID           uint
+	Body             string
+	PostSlug         string
+	PostTitle        string
+	PostAuthorID     uuid.UUID
+	PostCreatedAt    time.Time
+	CommentAuthorID  uuid.NullUUID
+	CommentCreatedAt *time.Time
+	Score            float64
+}
+
+// Search yalnızca yayımlanmış postlarda ve bunlara yazılmış yorumlarda arar,
+// sonuçları alaka puanına göre döner.
+func Search(db *gorm.DB, q Query) ([]Result, int64, error) {
+	terms := Terms(q.Text)
+	if len(terms) == 0 {
+		return nil, 0, ErrEmptyQuery
+	}
+
+	table := engine.Table()
+	query := db.Table(table).
+		Joins("JOIN posts ON posts.id = "+table+".post_id AND posts.deleted_at IS NULL AND posts.status = ?", models.PostStatusPublished).
+		Joins("LEFT JOIN comments ON "+table+".kind = ? AND comments.id = "+table+".ref_id AND comments.deleted_at IS NULL", KindComment).
+		Where("("+table+".kind = ? OR comments.id IS NOT NULL)", KindPost)
+	query = engine.Match(query, terms)
+
+	if q.Kind != "" {
+		query = query.Where(table+".kind = ?", q.Kind)
+	}
+	if q.AuthorID != nil {
+		query = query.Where("COALESCE(comments.author_id, posts.author_id) = ?", *q.AuthorID)
+	}
+	if len(q.CategoryIDs) > 0 {
+		query = query.Where("posts.id IN (SELECT post_id FROM post_categories WHERE category_id IN ?)", q.CategoryIDs)
+	}
+	if q.From != nil {
+		query = query.Where("COALESCE(comments.created_at, posts.created_at) >= ?", *q.From)
+	}
+	if q.To != nil {
+		query = query.Where("COALESCE(comments.created_at, posts.created_at) <= ?", *q.To)
+	}
+	if q.Until != nil {
+		query = query.Where("COALESCE(comments.created_at, posts.created_at) < ?", *q.Until)
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	rankSQL, rankArgs := engine.Rank(terms)
+	columns := fmt.Sprintf(`%[1]s.kind, %[1]s.ref_id, %[1]s.post_id, %[1]s.body,
+		posts.slug AS post_slug, posts.title AS post_title, posts.author_id AS post_author_id, posts.created_at AS post_created_at,
+		comments.author_id AS comment_author_id, comments.created_at AS comment_created_at, %[2]s AS score`, table, rankSQL)
+
+	var rows []resultRow
+	err := query.Select(columns, rankArgs...).
+		Order("score DESC").Order(table + ".ref_id DESC").
+		Offset(q.Offset).Limit(q.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]Result, 0, len(rows))
+	for _, row := range rows {
+		result := Result{
+			Kind:      row.Kind,
+			ID:        row.RefID,
+			PostID:    row.PostID,
+			PostSlug:  row.PostSlug,
+			PostTitle: row.PostTitle,
+			AuthorID:  row.PostAuthorID,
+			Snippet:   Snippet(row.Body, terms),
+			Rank:      row.Score,
+			CreatedAt: row.PostCreatedAt,
+		}
+		if row.Kind == KindComment {
+			result.AuthorID = row.CommentAuthorID.UUID
+			if row.CommentCreatedAt != nil {
+				result.CreatedAt = *row.CommentCreatedAt
+			}
+		}
+		results = append(results, result)
+	}
+	return results, total, nil
+}
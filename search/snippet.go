@@ -0,0 +1,132 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// snippetWords bir özette gösterilen en fazla kelime sayısıdır.
+const snippetWords = 30
+
+type segment struct {
+	text string
+	word bool
+}
+
+// Snippet metnin ilk eşleşme etrafındaki bölümünü döner. Terimlerden biriyle
+// başlayan kelimeler <mark> ile işaretlenir, geri kalan her şey kaçışlanır.
+// Özet metnin ortasından alındıysa kesilen uçlara "…" eklenir.
+func Snippet(text string, terms []string) string {
+	segments := splitWords(text)
+
+	var words []int
+	first := -1
+	for i, seg := range segments {
+		if !seg.word {
+			continue
+		}
+		if first < 0 && matchesAny(seg.text, terms) {
+			first = len(words)
+		}
+		words = append(words, i)
+	}
+	if len(words) == 0 {
+		return ""
+	}
+
+	// Eşleşme penceresin başına yakın olsun ki önceki bağlam da görünsün
+	start := 0
+	if first > snippetWords/3 {
+		start = first - snippetWords/3
+	}
+	end := start + snippetWords
+	if end > len(words) {
+		end = len(words)
+		if end-snippetWords > 0 {
+			start = end - snippetWords
+		} else {
+			start = 0
+		}
+	}
+
+	// Metnin kendi başı veya sonu gösteriliyorsa çevreleyen noktalama da alınır
+	from, to := words[start], words[end-1]
+	if start == 0 {
+		from = 0
+	}
+	if end == len(words) {
+		to = len(segments) - 1
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("… ")
+	}
+	for i := from; i <= to; i++ {
+		seg := segments[i]
+		switch {
+		case !seg.word:
+			b.WriteString(html.EscapeString(collapseSpace(seg.text)))
+		case matchesAny(seg.text, terms):
+			b.WriteString("<mark>")
+			b.WriteString(html.EscapeString(seg.text))
+			b.WriteString("</mark>")
+		default:
+			b.WriteString(html.EscapeString(seg.text))
+		}
+	}
+	if end < len(words) {
+		b.WriteString(" …")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// splitWords metni harf ve rakam grupları ile aralarındaki ayraçlara böler.
+func splitWords(text string) []segment {
+	var segments []segment
+	var current strings.Builder
+	inWord := false
+
+	for _, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if current.Len() > 0 && isWord != inWord {
+			segments = append(segments, segment{text: current.String(), word: inWord})
+			current.Reset()
+		}
+		inWord = isWord
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		segments = append(segments, segment{text: current.String(), word: inWord})
+	}
+	return segments
+}
+
+func matchesAny(word string, terms []string) bool {
+	folded := Fold(word)
+	for _, term := range terms {
+		if strings.HasPrefix(folded, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// collapseSpace satır sonları dahil art arda gelen boşlukları tek boşluğa indirir.
+func collapseSpace(value string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range value {
+		if unicode.IsSpace(r) {
+			if !space {
+				b.WriteByte(' ')
+			}
+			space = true
+			continue
+		}
+		space = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
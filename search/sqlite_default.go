@@ -0,0 +1,9 @@
+//go:build !sqlite_fts5
+
+package search
+
+// newSQLiteEngine FTS5 olmadan derlenmiş SQLite için LIKE motorunu döner.
+// FTS5 motorunu kullanmak için -tags sqlite_fts5 ile derleyin.
+func newSQLiteEngine() Engine {
+	return likeEngine{}
+}
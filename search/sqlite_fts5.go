@@ -0,0 +1,53 @@
+//go:build sqlite_fts5
+
+package search
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// newSQLiteEngine -tags sqlite_fts5 ile derlendiğinde FTS5 motorunu döner.
+func newSQLiteEngine() Engine {
+	return fts5Engine{}
+}
+
+// fts5Engine SQLite FTS5 sanal tablosunda bm25 ile sıralama yapar. Yalnızca
+// sadeleştirilmiş sütunlar indekslenir; diğerleri UNINDEXED olarak saklanır.
+type fts5Engine struct{}
+
+func (fts5Engine) Name() string  { return "sqlite-fts5" }
+func (fts5Engine) Table() string { return "search_fts" }
+
+func (fts5Engine) Migrate(db *gorm.DB) error {
+	return db.Exec(`CREATE VIRTUAL TABLE IF NOT EXISTS search_fts USING fts5(
+		kind UNINDEXED, ref_id UNINDEXED, post_id UNINDEXED, title UNINDEXED, body UNINDEXED,
+		search_title, search_body,
+		tokenize = 'unicode61'
+	)`).Error
+}
+
+// Upsert sanal tabloda benzersiz kısıt olmadığından önce eski belgeyi siler.
+func (fts5Engine) Upsert(tx *gorm.DB, doc Document) error {
+	if err := tx.Exec("DELETE FROM search_fts WHERE kind = ? AND ref_id = ?", doc.Kind, doc.RefID).Error; err != nil {
+		return err
+	}
+	return tx.Exec(`INSERT INTO search_fts (kind, ref_id, post_id, title, body, search_title, search_body)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		doc.Kind, doc.RefID, doc.PostID, doc.Title, doc.Body, searchText(doc.Title), searchText(doc.Body)).Error
+}
+
+func (fts5Engine) Match(query *gorm.DB, terms []string) *gorm.DB {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = `"` + term + `"*`
+	}
+	return query.Where("search_fts MATCH ?", strings.Join(parts, " "))
+}
+
+// Rank bm25 değerini çevirir; bm25 daha alakalı belgeler için daha küçüktür.
+// Ağırlıklar sütun sırasındadır: başlık eşleşmeleri gövdenin on katı sayılır.
+func (fts5Engine) Rank(terms []string) (string, []interface{}) {
+	return "-bm25(search_fts, 0, 0, 0, 0, 0, 10.0, 1.0)", nil
+}
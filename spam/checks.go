@@ -0,0 +1,233 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Kontrollerin eklediği puanlar. Varsayılan eşikler 3 (onaya bırak) ve 6
+// (reddet) olduğundan tek başına kesin spam sayılan işaretler 6 ve üstüdür.
+const (
+	extraLinkScore       = 1
+	blockedWordScore     = 3
+	blockedDomainScore   = 6
+	ownDuplicateScore    = 3
+	sharedDuplicateScore = 4
+	honeypotScore        = 10
+	tooFastScore         = 4
+	badFormTokenScore    = 2
+	missingFormToken     = badFormTokenScore
+	velocityScore        = 4
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"'()\[\]]+`)
+
+// Links metindeki bağlantıları döner.
+func Links(text string) []string {
+	return linkPattern.FindAllString(text, -1)
+}
+
+// linkHost bağlantının küçük harfli alan adını döner.
+func linkHost(link string) string {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
+// linkCheck izin verilenden fazla bağlantı içeren yorumları işaretler.
+type linkCheck struct {
+	max int
+}
+
+func (linkCheck) Name() string { return "links" }
+
+func (c linkCheck) Check(_ context.Context, s Submission) ([]Signal, error) {
+	count := len(Links(s.Text))
+	if count <= c.max {
+		return nil, nil
+	}
+	return []Signal{{Score: (count - c.max) * extraLinkScore, Reason: fmt.Sprintf("contains %d links", count)}}, nil
+}
+
+// blocklistCheck yasaklı kelime veya kelime gruplarını ve yasaklı alan
+// adlarına giden bağlantıları ya da e-posta adreslerini işaretler.
+type blocklistCheck struct {
+	phrases []string // Sadeleştirilmiş, kelimeleri tek boşlukla ayrılmış
+	domains []string
+}
+
+// newBlocklistCheck virgülle ayrılmış kelime ve alan adı listelerinden kontrolü kurar.
+func newBlocklistCheck(words, domains string) blocklistCheck {
+	var check blocklistCheck
+	for _, word := range strings.Split(words, ",") {
+		if phrase := strings.Join(normalizedWords(word), " "); phrase != "" {
+			check.phrases = append(check.phrases, phrase)
+		}
+	}
+	for _, domain := range strings.Split(domains, ",") {
+		if domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www."); domain != "" {
+			check.domains = append(check.domains, domain)
+		}
+	}
+	return check
+}
+
+func (blocklistCheck) Name() string { return "blocklist" }
+
+func (c blocklistCheck) Check(_ context.Context, s Submission) ([]Signal, error) {
+	var signals []Signal
+
+	// Kelime sınırında eşleşmesi için metin boşluklarla çevrilir
+	text := " " + strings.Join(normalizedWords(s.Text+" "+s.Username), " ") + " "
+	for _, phrase := range c.phrases {
+		if strings.Contains(text, " "+phrase+" ") {
+			signals = append(signals, Signal{Score: blockedWordScore, Reason: fmt.Sprintf("contains blocked word %q", phrase)})
+		}
+	}
+
+	hosts := make([]string, 0)
+	for _, link := range Links(s.Text) {
+		hosts = append(hosts, linkHost(link))
+	}
+	if at := strings.LastIndex(s.Email, "@"); at >= 0 {
+		hosts = append(hosts, strings.ToLower(s.Email[at+1:]))
+	}
+	for _, domain := range c.domains {
+		for _, host := range hosts {
+			if host == domain || strings.HasSuffix(host, "."+domain) {
+				signals = append(signals, Signal{Score: blockedDomainScore, Reason: fmt.Sprintf("uses blocked domain %s", domain)})
+				break
+			}
+		}
+	}
+	return signals, nil
+}
+
+// duplicateCheck aynı metnin yakın zamanda tekrar gönderilmesini işaretler.
+// Başka hesaplardan gelen kopyalar, aynı kullanıcının tekrarından daha şüphelidir.
+type duplicateCheck struct {
+	db     *gorm.DB
+	window time.Duration
+}
+
+func (duplicateCheck) Name() string { return "duplicate" }
+
+func (c duplicateCheck) Check(ctx context.Context, s Submission) ([]Signal, error) {
+	fingerprint := Fingerprint(s.Text)
+	if s.Kind != KindComment || fingerprint == "" {
+		return nil, nil
+	}
+
+	recent := func() *gorm.DB {
+		return c.db.WithContext(ctx).Model(&submissionRecord{}).
+			Where("kind = ? AND fingerprint = ? AND created_at >= ?", s.Kind, fingerprint, s.At.Add(-c.window))
+	}
+	var total, own int64
+	if err := recent().Count(&total).Error; err != nil || total == 0 {
+		return nil, err
+	}
+	if s.UserID != nil {
+		if err := recent().Where("user_id = ?", *s.UserID).Count(&own).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	var signals []Signal
+	if own > 0 {
+		signals = append(signals, Signal{Score: ownDuplicateScore, Reason: "repeats a recent comment"})
+	}
+	if others := total - own; others > 0 {
+		signals = append(signals, Signal{Score: sharedDuplicateScore, Reason: fmt.Sprintf("same text was posted %d time(s) by other accounts", others)})
+	}
+	return signals, nil
+}
+
+// timingCheck doldurulmuş tuzak alanını ve formun bir insan için fazla hızlı
+// gönderilmesini işaretler. Token'ı eksik, geçersiz, başka bir adrese
+// verilmiş veya yeniden kullanılmış gönderimler de puan alır. Yalnızca yeni
+// yorum ve kayıt formlarına uygulanır; düzenlemeler zaten bu formlardan geçmiş
+// içeriğe yapılır.
+type timingCheck struct {
+	db     *gorm.DB
+	minAge time.Duration
+}
+
+func (timingCheck) Name() string { return "timing" }
+
+func (c timingCheck) Check(ctx context.Context, s Submission) ([]Signal, error) {
+	if s.Kind != KindComment && s.Kind != KindRegistration {
+		return nil, nil
+	}
+
+	var signals []Signal
+	if strings.TrimSpace(s.Honeypot) != "" {
+		signals = append(signals, Signal{Score: honeypotScore, Reason: "honeypot field was filled"})
+	}
+	if s.FormToken == "" {
+		return append(signals, Signal{Score: missingFormToken, Reason: "form token is missing"}), nil
+	}
+
+	age, err := FormTokenAge(s.FormToken, s.IP, s.At)
+	if err != nil {
+		return append(signals, Signal{Score: badFormTokenScore, Reason: "form token is invalid or expired"}), nil
+	}
+	if age < c.minAge {
+		signals = append(signals, Signal{Score: tooFastScore, Reason: fmt.Sprintf("form was submitted %s after it was opened", age.Round(time.Millisecond))})
+	}
+
+	var firstUse []time.Time
+	err = c.db.WithContext(ctx).Model(&submissionRecord{}).Where("form_token = ?", s.FormToken).
+		Order("created_at").Limit(1).Pluck("created_at", &firstUse).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(firstUse) > 0 && s.At.Sub(firstUse[0]) > FormTokenReuseWindow {
+		signals = append(signals, Signal{Score: badFormTokenScore, Reason: "form token was already used"})
+	}
+	return signals, nil
+}
+
+// velocityCheck kısa sürede çok sayıda gönderimi işaretler: yorumlar ve
+// düzenlemeler için kullanıcı başına dakikada, kayıtlar için IP başına saatte.
+type velocityCheck struct {
+	db                *gorm.DB
+	commentLimit      int
+	registrationLimit int
+}
+
+func (velocityCheck) Name() string { return "velocity" }
+
+func (c velocityCheck) Check(ctx context.Context, s Submission) ([]Signal, error) {
+	query := c.db.WithContext(ctx).Model(&submissionRecord{}).Where("kind = ?", s.Kind)
+	var limit int
+	var window time.Duration
+	var period string
+	switch {
+	case (s.Kind == KindComment || s.Kind == KindCommentEdit) && s.UserID != nil:
+		query, limit, window, period = query.Where("user_id = ?", *s.UserID), c.commentLimit, time.Minute, "minute"
+	case s.Kind == KindRegistration && s.IP != "":
+		query, limit, window, period = query.Where("ip = ?", s.IP), c.registrationLimit, time.Hour, "hour"
+	default:
+		return nil, nil
+	}
+
+	var count int64
+	if err := query.Where("created_at >= ?", s.At.Add(-window)).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count < int64(limit) {
+		return nil, nil
+	}
+	return []Signal{{Score: velocityScore, Reason: fmt.Sprintf("%d %s submissions in the last %s", count, strings.ReplaceAll(s.Kind, "_", " "), period)}}, nil
+}
@@ -0,0 +1,75 @@
+package spam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPClassifier gönderimi JSON olarak harici bir servise yollar ve
+// {"score": 0.93} biçimindeki yanıttan spam olasılığını okur.
+type HTTPClassifier struct {
+	URL    string
+	Token  string // Tanımlıysa Authorization: Bearer olarak gönderilir
+	Client *http.Client
+}
+
+// NewHTTPClassifier verilen zaman aşımıyla bir HTTP sınıflandırıcısı oluşturur.
+func NewHTTPClassifier(url, token string, timeout time.Duration) *HTTPClassifier {
+	return &HTTPClassifier{URL: url, Token: token, Client: &http.Client{Timeout: timeout}}
+}
+
+type classifierRequest struct {
+	Kind     string `json:"kind"`
+	Content  string `json:"content,omitempty"`
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+	IP       string `json:"ip,omitempty"`
+}
+
+type classifierResponse struct {
+	Score *float64 `json:"score"`
+}
+
+func (h *HTTPClassifier) Classify(ctx context.Context, s Submission) (float64, error) {
+	body, err := json.Marshal(classifierRequest{
+		Kind:     s.Kind,
+		Content:  s.Text,
+		Username: s.Username,
+		Email:    s.Email,
+		IP:       s.IP,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.Token)
+	}
+
+	resp, err := h.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("classifier returned %s", resp.Status)
+	}
+
+	var result classifierResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	if result.Score == nil || *result.Score < 0 || *result.Score > 1 {
+		return 0, fmt.Errorf("classifier returned an invalid score")
+	}
+	return *result.Score, nil
+}
@@ -0,0 +1,89 @@
+package spam
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FormTokenTTL bir form token'ının geçerli kaldığı süredir.
+const FormTokenTTL = 24 * time.Hour
+
+// FormTokenReuseWindow bir token'ın ilk kullanımından sonra tekrar
+// gönderilebileceği süredir; doğrulama hatası alan form yeniden gönderilebilir
+// ancak tek bir token'la sürekli gönderim yapılamaz.
+const FormTokenReuseWindow = 10 * time.Minute
+
+// ErrInvalidFormToken token bozuk, imzası hatalı, başka bir adrese verilmiş
+// veya süresi geçmişse döner.
+var ErrInvalidFormToken = errors.New("invalid form token")
+
+var (
+	formSecret     []byte
+	formSecretOnce sync.Once
+)
+
+// secret SPAM_FORM_SECRET'i, tanımlı değilse süreç boyunca geçerli rastgele bir anahtarı döner.
+func secret() []byte {
+	formSecretOnce.Do(func() {
+		if value := os.Getenv("SPAM_FORM_SECRET"); value != "" {
+			formSecret = []byte(value)
+			return
+		}
+		log.Println("SPAM_FORM_SECRET is not set, using an ephemeral key; form tokens will not survive a restart")
+		formSecret = make([]byte, 32)
+		if _, err := rand.Read(formSecret); err != nil {
+			panic(err)
+		}
+	})
+	return formSecret
+}
+
+// IssueFormToken formun açıldığı zamanı taşıyan, istemcinin IP adresine
+// bağlı imzalı bir token üretir. İstemci formu gönderirken token'ı geri
+// yollar; böylece formun ne kadar sürede doldurulduğu ölçülebilir.
+func IssueFormToken(now time.Time, ip string) (string, error) {
+	nonce := make([]byte, 9)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	payload := strconv.FormatInt(now.Unix(), 10) + "." + hex.EncodeToString(nonce)
+	return payload + "." + sign(payload, ip), nil
+}
+
+// FormTokenAge token'ın üretilmesinden bu yana geçen süreyi döner. Token
+// başka bir IP adresine verilmişse ErrInvalidFormToken döner.
+func FormTokenAge(token, ip string, now time.Time) (time.Duration, error) {
+	separator := strings.LastIndex(token, ".")
+	if separator < 0 {
+		return 0, ErrInvalidFormToken
+	}
+	payload, signature := token[:separator], token[separator+1:]
+	if !hmac.Equal([]byte(signature), []byte(sign(payload, ip))) {
+		return 0, ErrInvalidFormToken
+	}
+	issued, _, _ := strings.Cut(payload, ".")
+	unix, err := strconv.ParseInt(issued, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidFormToken
+	}
+	age := now.Sub(time.Unix(unix, 0))
+	if age < 0 || age > FormTokenTTL {
+		return 0, ErrInvalidFormToken
+	}
+	return age, nil
+}
+
+func sign(payload, ip string) string {
+	mac := hmac.New(sha256.New, secret())
+	mac.Write([]byte(payload + "|" + ip))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
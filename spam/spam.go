@@ -0,0 +1,204 @@
+// Package spam yorum ve kayıt gönderimlerini yerel kontrollerle ve isteğe
+// bağlı harici bir sınıflandırıcıyla puanlar. Toplam puan gönderimin kabul
+// edileceğine, onaya bırakılacağına veya reddedileceğine karar verir.
+package spam
+
+import (
+	"blog-platform/utils"
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	KindComment      = "comment"
+	KindCommentEdit  = "comment_edit"
+	KindRegistration = "registration"
+)
+
+const (
+	ActionAccept   = "accept"
+	ActionModerate = "moderate"
+	ActionReject   = "reject"
+)
+
+// Submission puanlanacak gönderimdir. Kontroller yalnızca türe uygun alanlara bakar.
+type Submission struct {
+	Kind     string
+	UserID   *uuid.UUID // Kayıtta boştur
+	IP       string
+	Text     string // Yorumun ham içeriği; düzenlemede yeni içerik
+	Username string
+	Email    string
+	// Formda gizlenen ve insanların boş bıraktığı alan
+	Honeypot string
+	// Form açılırken IssueFormToken ile alınan token
+	FormToken string
+	At        time.Time
+}
+
+// Signal bir kontrolün gönderime eklediği puan ve nedenidir.
+type Signal struct {
+	Score  int
+	Reason string
+}
+
+// Checker tek bir spam kontrolüdür; şüpheli bir şey bulamazsa boş liste döner.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context, s Submission) ([]Signal, error)
+}
+
+// Classifier harici bir spam sınıflandırıcısının adaptörüdür. Gönderimin spam
+// olma olasılığını 0 ile 1 arasında döner.
+type Classifier interface {
+	Classify(ctx context.Context, s Submission) (float64, error)
+}
+
+// Result gönderimin toplam puanı, kararı ve puanı oluşturan nedenlerdir.
+type Result struct {
+	Score   int
+	Action  string
+	Reasons []string
+	// Kaydedilen gönderimin ID'si; dedektörün veritabanı yoksa 0
+	SubmissionID uint
+}
+
+// Reason nedenleri tek satırda birleştirir.
+func (r Result) Reason() string {
+	return strings.Join(r.Reasons, "; ")
+}
+
+// Detector kontrolleri çalıştırıp puanları toplar ve eşiklere göre karar verir.
+type Detector struct {
+	Checks     []Checker
+	Classifier Classifier
+	// Sınıflandırıcının olasılığı bu katsayıyla puana çevrilir
+	ClassifierWeight int
+	ModerateScore    int
+	RejectScore      int
+
+	db *gorm.DB // Gönderimler yinelenen içerik ve hız kontrolleri için kaydedilir
+}
+
+var (
+	// detector Init çağrılana kadar her gönderimi kabul eder. Değiştirilirken
+	// yerine yenisi konur; çalışan bir Evaluate eski dedektörle tamamlanır.
+	detector   = &Detector{ModerateScore: math.MaxInt, RejectScore: math.MaxInt}
+	detectorMu sync.RWMutex
+)
+
+// Init gönderim tablosunu oluşturur ve yerel kontrolleri ortam
+// değişkenlerindeki ayarlarla kurar. SPAM_CLASSIFIER_URL tanımlıysa HTTP
+// sınıflandırıcısı da eklenir.
+func Init(db *gorm.DB) error {
+	if err := db.AutoMigrate(&submissionRecord{}); err != nil {
+		return err
+	}
+
+	d := &Detector{
+		Checks: []Checker{
+			linkCheck{max: utils.GetEnvInt("SPAM_MAX_LINKS", 2)},
+			newBlocklistCheck(os.Getenv("SPAM_BLOCKED_WORDS"), os.Getenv("SPAM_BLOCKED_DOMAINS")),
+			duplicateCheck{db: db, window: utils.GetEnvDuration("SPAM_DUPLICATE_WINDOW", 24*time.Hour)},
+			timingCheck{db: db, minAge: utils.GetEnvDuration("SPAM_MIN_SUBMIT_TIME", 3*time.Second)},
+			velocityCheck{
+				db:                db,
+				commentLimit:      utils.GetEnvInt("SPAM_MAX_COMMENTS_PER_MINUTE", 5),
+				registrationLimit: utils.GetEnvInt("SPAM_MAX_REGISTRATIONS_PER_HOUR", 5),
+			},
+		},
+		ClassifierWeight: utils.GetEnvInt("SPAM_CLASSIFIER_WEIGHT", 6),
+		ModerateScore:    utils.GetEnvInt("SPAM_MODERATE_SCORE", 3),
+		RejectScore:      utils.GetEnvInt("SPAM_REJECT_SCORE", 6),
+		db:               db,
+	}
+	if url := os.Getenv("SPAM_CLASSIFIER_URL"); url != "" {
+		d.Classifier = NewHTTPClassifier(url, os.Getenv("SPAM_CLASSIFIER_TOKEN"), utils.GetEnvDuration("SPAM_CLASSIFIER_TIMEOUT", 2*time.Second))
+	}
+	detectorMu.Lock()
+	detector = d
+	detectorMu.Unlock()
+	return nil
+}
+
+// SetClassifier harici sınıflandırıcıyı değiştirir; nil sınıflandırıcıyı kapatır.
+// Testlerde sahte bir sınıflandırıcı takmak için de kullanılır.
+func SetClassifier(classifier Classifier) {
+	detectorMu.Lock()
+	defer detectorMu.Unlock()
+	updated := *detector
+	updated.Classifier = classifier
+	detector = &updated
+}
+
+// Evaluate gönderimi etkin dedektörle puanlar.
+func Evaluate(ctx context.Context, s Submission) (Result, error) {
+	detectorMu.RLock()
+	d := detector
+	detectorMu.RUnlock()
+	return d.Evaluate(ctx, s)
+}
+
+// Evaluate tüm kontrolleri ve varsa sınıflandırıcıyı çalıştırır, kararı verir
+// ve gönderimi sonraki kontroller için kaydeder. Sınıflandırıcıya
+// ulaşılamazsa yalnızca yerel kontrollerin puanı kullanılır.
+func (d *Detector) Evaluate(ctx context.Context, s Submission) (Result, error) {
+	if s.At.IsZero() {
+		s.At = time.Now()
+	}
+
+	var result Result
+	add := func(signal Signal) {
+		if signal.Score > 0 {
+			result.Score += signal.Score
+			result.Reasons = append(result.Reasons, signal.Reason)
+		}
+	}
+	for _, check := range d.Checks {
+		signals, err := check.Check(ctx, s)
+		if err != nil {
+			return result, fmt.Errorf("%s check: %w", check.Name(), err)
+		}
+		for _, signal := range signals {
+			add(signal)
+		}
+	}
+	if d.Classifier != nil {
+		probability, err := d.Classifier.Classify(ctx, s)
+		if err != nil {
+			log.Printf("Spam classifier failed: %v", err)
+		} else {
+			add(Signal{
+				Score:  int(math.Round(probability * float64(d.ClassifierWeight))),
+				Reason: fmt.Sprintf("classifier rated %.0f%% spam", probability*100),
+			})
+		}
+	}
+
+	switch {
+	case result.Score >= d.RejectScore:
+		result.Action = ActionReject
+	case result.Score >= d.ModerateScore:
+		result.Action = ActionModerate
+	default:
+		result.Action = ActionAccept
+	}
+
+	if d.db != nil {
+		id, err := record(d.db, s, result)
+		if err != nil {
+			return result, err
+		}
+		result.SubmissionID = id
+	}
+	return result, nil
+}
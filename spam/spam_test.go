@@ -0,0 +1,457 @@
+package spam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const testIP = "203.0.113.7"
+
+var testNow = time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.NewString())), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&submissionRecord{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// stubClassifier sabit bir olasılık veya hata döner ve kaç kez çağrıldığını sayar.
+type stubClassifier struct {
+	probability float64
+	err         error
+	calls       int
+}
+
+func (s *stubClassifier) Classify(context.Context, Submission) (float64, error) {
+	s.calls++
+	return s.probability, s.err
+}
+
+// fixedCheck her gönderime aynı puanı verir.
+type fixedCheck int
+
+func (fixedCheck) Name() string { return "fixed" }
+
+func (f fixedCheck) Check(context.Context, Submission) ([]Signal, error) {
+	return []Signal{{Score: int(f), Reason: fmt.Sprintf("fixed %d", int(f))}}, nil
+}
+
+func totalScore(signals []Signal) int {
+	total := 0
+	for _, signal := range signals {
+		total += signal.Score
+	}
+	return total
+}
+
+func comment(user uuid.UUID, text string) Submission {
+	return Submission{Kind: KindComment, UserID: &user, IP: testIP, Text: text, At: testNow}
+}
+
+func issueToken(t *testing.T, at time.Time, ip string) string {
+	t.Helper()
+	token, err := IssueFormToken(at, ip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestLinkCheck(t *testing.T) {
+	check := linkCheck{max: 2}
+	cases := []struct {
+		text  string
+		score int
+	}{
+		{"no links here", 0},
+		{"see https://a.example and www.b.example", 0},
+		{"https://a.example http://b.example www.c.example https://d.example/path?q=1", 2},
+	}
+	for _, tc := range cases {
+		signals, err := check.Check(context.Background(), comment(uuid.New(), tc.text))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := totalScore(signals); got != tc.score {
+			t.Errorf("%q scored %d, want %d", tc.text, got, tc.score)
+		}
+	}
+}
+
+func TestBlocklistCheck(t *testing.T) {
+	check := newBlocklistCheck("casino, cheap pills", "spam.example")
+	cases := []struct {
+		name  string
+		s     Submission
+		score int
+	}{
+		{"clean", comment(uuid.New(), "a normal comment"), 0},
+		{"word", comment(uuid.New(), "Best CASINO bonus"), blockedWordScore},
+		{"word inside another word", comment(uuid.New(), "casinos are not matched"), 0},
+		{"phrase across punctuation", comment(uuid.New(), "buy cheap, pills"), blockedWordScore},
+		{"linked domain", comment(uuid.New(), "go to https://spam.example/offer"), blockedDomainScore},
+		{"linked subdomain", comment(uuid.New(), "www.deals.spam.example"), blockedDomainScore},
+		{"lookalike domain", comment(uuid.New(), "https://notspam.example"), 0},
+		{"username", Submission{Kind: KindRegistration, Username: "casino_king", Email: "a@mail.example"}, blockedWordScore},
+		{"email domain", Submission{Kind: KindRegistration, Username: "alice", Email: "alice@spam.example"}, blockedDomainScore},
+	}
+	for _, tc := range cases {
+		signals, err := check.Check(context.Background(), tc.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := totalScore(signals); got != tc.score {
+			t.Errorf("%s scored %d, want %d", tc.name, got, tc.score)
+		}
+	}
+}
+
+func TestDuplicateCheck(t *testing.T) {
+	db := newTestDB(t)
+	check := duplicateCheck{db: db, window: time.Hour}
+	author, other := uuid.New(), uuid.New()
+	text := "Great post, check out my website"
+
+	if _, err := record(db, comment(author, text), Result{Action: ActionAccept}); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name  string
+		s     Submission
+		score int
+	}{
+		{"same author", comment(author, "great post; CHECK out my website!"), ownDuplicateScore},
+		{"other account", comment(other, text), sharedDuplicateScore},
+		{"different text", comment(author, "a completely different comment"), 0},
+		{"short text", comment(author, "thanks"), 0},
+	}
+	for _, tc := range cases {
+		signals, err := check.Check(context.Background(), tc.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := totalScore(signals); got != tc.score {
+			t.Errorf("%s scored %d, want %d", tc.name, got, tc.score)
+		}
+	}
+
+	outside := comment(other, text)
+	outside.At = testNow.Add(2 * time.Hour)
+	signals, err := check.Check(context.Background(), outside)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signals) != 0 {
+		t.Errorf("duplicate outside the window was flagged: %v", signals)
+	}
+}
+
+func TestTimingCheck(t *testing.T) {
+	db := newTestDB(t)
+	check := timingCheck{db: db, minAge: 3 * time.Second}
+	user := uuid.New()
+
+	withToken := func(token string) Submission {
+		s := comment(user, "hello there")
+		s.FormToken = token
+		return s
+	}
+	honeypot := withToken(issueToken(t, testNow.Add(-time.Minute), testIP))
+	honeypot.Honeypot = "http://spam.example"
+	edit := comment(user, "edited")
+	edit.Kind = KindCommentEdit
+
+	cases := []struct {
+		name  string
+		s     Submission
+		score int
+	}{
+		{"valid token", withToken(issueToken(t, testNow.Add(-time.Minute), testIP)), 0},
+		{"honeypot", honeypot, honeypotScore},
+		{"missing token", withToken(""), missingFormToken},
+		{"forged token", withToken("1767355200.abcdef.0123456789abcdef"), badFormTokenScore},
+		{"token for another address", withToken(issueToken(t, testNow.Add(-time.Minute), "198.51.100.1")), badFormTokenScore},
+		{"expired token", withToken(issueToken(t, testNow.Add(-FormTokenTTL-time.Minute), testIP)), badFormTokenScore},
+		{"too fast", withToken(issueToken(t, testNow.Add(-time.Second), testIP)), tooFastScore},
+		{"edit without token", edit, 0},
+	}
+	for _, tc := range cases {
+		signals, err := check.Check(context.Background(), tc.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := totalScore(signals); got != tc.score {
+			t.Errorf("%s scored %d, want %d (%v)", tc.name, got, tc.score, signals)
+		}
+	}
+}
+
+func TestTimingCheckTokenReuse(t *testing.T) {
+	db := newTestDB(t)
+	check := timingCheck{db: db, minAge: 3 * time.Second}
+	s := comment(uuid.New(), "hello there")
+	s.FormToken = issueToken(t, testNow.Add(-time.Minute), testIP)
+	if _, err := record(db, s, Result{Action: ActionAccept}); err != nil {
+		t.Fatal(err)
+	}
+
+	retry := s
+	retry.At = testNow.Add(FormTokenReuseWindow / 2)
+	if signals, _ := check.Check(context.Background(), retry); totalScore(signals) != 0 {
+		t.Errorf("resubmitting within the reuse window was flagged: %v", signals)
+	}
+
+	replay := s
+	replay.At = testNow.Add(FormTokenReuseWindow + time.Minute)
+	if signals, _ := check.Check(context.Background(), replay); totalScore(signals) != badFormTokenScore {
+		t.Errorf("replayed token scored %d, want %d", totalScore(signals), badFormTokenScore)
+	}
+}
+
+func TestVelocityCheck(t *testing.T) {
+	db := newTestDB(t)
+	check := velocityCheck{db: db, commentLimit: 2, registrationLimit: 1}
+	user := uuid.New()
+
+	for i := 0; i < 2; i++ {
+		s := comment(user, fmt.Sprintf("comment %d", i))
+		signals, err := check.Check(context.Background(), s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(signals) != 0 {
+			t.Fatalf("comment %d within the limit was flagged", i)
+		}
+		if _, err := record(db, s, Result{Action: ActionAccept}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if signals, _ := check.Check(context.Background(), comment(user, "one more")); totalScore(signals) != velocityScore {
+		t.Errorf("comment over the limit scored %d", totalScore(signals))
+	}
+	if signals, _ := check.Check(context.Background(), comment(uuid.New(), "someone else")); len(signals) != 0 {
+		t.Error("the limit must be per user")
+	}
+	later := comment(user, "a minute later")
+	later.At = testNow.Add(2 * time.Minute)
+	if signals, _ := check.Check(context.Background(), later); len(signals) != 0 {
+		t.Error("old comments must not count")
+	}
+
+	registration := Submission{Kind: KindRegistration, IP: testIP, Username: "bot1", At: testNow}
+	if _, err := record(db, registration, Result{Action: ActionAccept}); err != nil {
+		t.Fatal(err)
+	}
+	registration.Username = "bot2"
+	if signals, _ := check.Check(context.Background(), registration); totalScore(signals) != velocityScore {
+		t.Errorf("registration over the limit scored %d", totalScore(signals))
+	}
+	registration.IP = "198.51.100.1"
+	if signals, _ := check.Check(context.Background(), registration); len(signals) != 0 {
+		t.Error("the registration limit must be per IP address")
+	}
+}
+
+func TestForgetUser(t *testing.T) {
+	db := newTestDB(t)
+	user, other := uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{user, user, other} {
+		if _, err := record(db, comment(id, "some comment text"), Result{Action: ActionAccept}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Kayıt gönderimleri kullanıcı oluşturulmadan önce kaydedilir ve sonra bağlanır
+	registration := Submission{Kind: KindRegistration, IP: testIP, Username: "alice", At: testNow}
+	linked, err := record(db, registration, Result{Action: ActionAccept})
+	if err != nil {
+		t.Fatal(err)
+	}
+	registration.Username = "bob"
+	if _, err := record(db, registration, Result{Action: ActionAccept}); err != nil {
+		t.Fatal(err)
+	}
+	if err := LinkUser(db, linked, user); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ForgetUser(db, user); err != nil {
+		t.Fatal(err)
+	}
+	var remaining []submissionRecord
+	if err := db.Order("id").Find(&remaining).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 2 || remaining[0].UserID == nil || *remaining[0].UserID != other || remaining[1].UserID != nil {
+		t.Errorf("unexpected records left: %+v", remaining)
+	}
+}
+
+func TestEvaluateReturnsSubmissionID(t *testing.T) {
+	db := newTestDB(t)
+	d := &Detector{ModerateScore: 3, RejectScore: 6, db: db}
+	result, err := d.Evaluate(context.Background(), Submission{Kind: KindRegistration, IP: testIP, Username: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := uuid.New()
+	if err := LinkUser(db, result.SubmissionID, user); err != nil {
+		t.Fatal(err)
+	}
+
+	var stored submissionRecord
+	if err := db.First(&stored, result.SubmissionID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.UserID == nil || *stored.UserID != user {
+		t.Errorf("registration was not linked to the user: %+v", stored)
+	}
+}
+
+func TestEvaluateThresholds(t *testing.T) {
+	cases := []struct {
+		score  int
+		action string
+	}{
+		{0, ActionAccept},
+		{2, ActionAccept},
+		{3, ActionModerate},
+		{5, ActionModerate},
+		{6, ActionReject},
+		{10, ActionReject},
+	}
+	for _, tc := range cases {
+		d := &Detector{Checks: []Checker{fixedCheck(tc.score)}, ModerateScore: 3, RejectScore: 6}
+		result, err := d.Evaluate(context.Background(), comment(uuid.New(), "text"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Score != tc.score || result.Action != tc.action {
+			t.Errorf("score %d gave %d/%s, want %s", tc.score, result.Score, result.Action, tc.action)
+		}
+	}
+}
+
+func TestEvaluateRecordsSubmissions(t *testing.T) {
+	db := newTestDB(t)
+	d := &Detector{Checks: []Checker{fixedCheck(4)}, ModerateScore: 3, RejectScore: 6, db: db}
+	if _, err := d.Evaluate(context.Background(), comment(uuid.New(), "text")); err != nil {
+		t.Fatal(err)
+	}
+
+	var stored submissionRecord
+	if err := db.First(&stored).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.Action != ActionModerate || stored.Score != 4 || stored.Reasons != "fixed 4" {
+		t.Errorf("unexpected record %+v", stored)
+	}
+}
+
+func TestEvaluateWithClassifier(t *testing.T) {
+	classifier := &stubClassifier{probability: 0.5}
+	d := &Detector{Checks: []Checker{fixedCheck(1)}, Classifier: classifier, ClassifierWeight: 6, ModerateScore: 3, RejectScore: 6}
+
+	result, err := d.Evaluate(context.Background(), comment(uuid.New(), "text"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if classifier.calls != 1 || result.Score != 4 || result.Action != ActionModerate {
+		t.Errorf("got %+v after %d classifier calls", result, classifier.calls)
+	}
+	if !strings.Contains(result.Reason(), "classifier rated 50% spam") {
+		t.Errorf("classifier reason missing: %q", result.Reason())
+	}
+
+	// Sınıflandırıcı hata verirse yalnızca yerel puan kullanılır
+	classifier.err = errors.New("unavailable")
+	result, err = d.Evaluate(context.Background(), comment(uuid.New(), "text"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Score != 1 || result.Action != ActionAccept {
+		t.Errorf("failed classifier changed the result: %+v", result)
+	}
+}
+
+func TestSetClassifier(t *testing.T) {
+	detectorMu.RLock()
+	previous := detector
+	detectorMu.RUnlock()
+	t.Cleanup(func() {
+		detectorMu.Lock()
+		detector = previous
+		detectorMu.Unlock()
+	})
+
+	detectorMu.Lock()
+	detector = &Detector{ClassifierWeight: 6, ModerateScore: 3, RejectScore: 6}
+	detectorMu.Unlock()
+
+	classifier := &stubClassifier{probability: 1}
+	SetClassifier(classifier)
+	result, err := Evaluate(context.Background(), comment(uuid.New(), "text"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Action != ActionReject || classifier.calls != 1 {
+		t.Errorf("stub classifier was not used: %+v", result)
+	}
+
+	SetClassifier(nil)
+	if result, _ := Evaluate(context.Background(), comment(uuid.New(), "text")); result.Action != ActionAccept {
+		t.Errorf("removing the classifier did not take effect: %+v", result)
+	}
+}
+
+func TestHTTPClassifier(t *testing.T) {
+	var received classifierRequest
+	score := `{"score": 0.9}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.Write([]byte(score))
+	}))
+	defer server.Close()
+
+	classifier := NewHTTPClassifier(server.URL, "secret", time.Second)
+	probability, err := classifier.Classify(context.Background(), comment(uuid.New(), "buy now"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if probability != 0.9 || received.Kind != KindComment || received.Content != "buy now" || received.IP != testIP {
+		t.Errorf("got %v with request %+v", probability, received)
+	}
+
+	for _, invalid := range []string{`{"score": 1.5}`, `{}`, `not json`} {
+		score = invalid
+		if _, err := classifier.Classify(context.Background(), comment(uuid.New(), "x")); err == nil {
+			t.Errorf("response %s was accepted", invalid)
+		}
+	}
+
+	classifier.Token = "wrong"
+	if _, err := classifier.Classify(context.Background(), comment(uuid.New(), "x")); err == nil {
+		t.Error("non-200 response was accepted")
+	}
+}
@@ -0,0 +1,95 @@
+package spam
+
+import (
+	"blog-platform/utils"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// submissionRetention gönderim kayıtlarının saklanma süresidir; kontrollerin
+// baktığı pencerelerden uzun olmalıdır.
+const submissionRetention = 7 * 24 * time.Hour
+
+// minFingerprintWords bundan kısa metinler ("Teşekkürler!" gibi) yinelenen
+// içerik sayılmaz.
+const minFingerprintWords = 4
+
+// submissionRecord puanlanan her gönderimin kaydıdır. Yinelenen içerik ve
+// hız kontrolleri bu tabloya bakar.
+type submissionRecord struct {
+	ID          uint       `gorm:"primaryKey"`
+	Kind        string     `gorm:"index;not null"`
+	UserID      *uuid.UUID `gorm:"type:uuid;index"`
+	IP          string     `gorm:"index"`
+	Fingerprint string     `gorm:"index"`
+	FormToken   string     `gorm:"index"` // Token'ın tekrar kullanımını saptamak için
+	Score       int
+	Action      string
+	Reasons     string
+	CreatedAt   time.Time `gorm:"index"`
+}
+
+func (submissionRecord) TableName() string {
+	return "spam_submissions"
+}
+
+// record gönderimi kaydeder, kaydın ID'sini döner ve saklama süresini aşmış
+// kayıtları siler.
+func record(db *gorm.DB, s Submission, result Result) (uint, error) {
+	entry := submissionRecord{
+		Kind:        s.Kind,
+		UserID:      s.UserID,
+		IP:          s.IP,
+		Fingerprint: Fingerprint(s.Text),
+		FormToken:   s.FormToken,
+		Score:       result.Score,
+		Action:      result.Action,
+		Reasons:     result.Reason(),
+		CreatedAt:   s.At,
+	}
+	if err := db.Create(&entry).Error; err != nil {
+		return 0, err
+	}
+	return entry.ID, db.Where("created_at < ?", s.At.Add(-submissionRetention)).Delete(&submissionRecord{}).Error
+}
+
+// LinkUser henüz kullanıcısı olmayan bir gönderimi, örneğin kaydı yapılan
+// hesabın kayıt gönderimini, kullanıcıya bağlar. Böylece hesap silinirken
+// ForgetUser bu kaydı ve IP adresini de siler.
+func LinkUser(db *gorm.DB, submissionID uint, userID uuid.UUID) error {
+	if submissionID == 0 {
+		return nil
+	}
+	return db.Model(&submissionRecord{}).Where("id = ? AND user_id IS NULL", submissionID).Update("user_id", userID).Error
+}
+
+// ForgetUser kullanıcının gönderim kayıtlarını, IP adresleriyle birlikte
+// siler. Hesap kalıcı olarak silinirken çağrılır.
+func ForgetUser(tx *gorm.DB, userID uuid.UUID) error {
+	return tx.Where("user_id = ?", userID).Delete(&submissionRecord{}).Error
+}
+
+// Fingerprint metnin büyük/küçük harf, aksan, noktalama ve boşluk
+// farklarından bağımsız özetini döner. Kısa metinler için boş döner.
+func Fingerprint(text string) string {
+	fields := normalizedWords(text)
+	if len(fields) < minFingerprintWords {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, " ")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// normalizedWords metni küçük harfe çevirip ASCII'ye indirger ve harf ile
+// rakam gruplarına böler.
+func normalizedWords(text string) []string {
+	return strings.FieldsFunc(utils.Transliterate(strings.ToLower(text)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
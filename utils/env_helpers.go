@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetEnvDuration ortam değişkenini time.Duration olarak okur; tanımlı değilse
+// veya geçersizse varsayılan değeri döner.
+func GetEnvDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid duration for %s: %v, using %s", key, err, fallback)
+		return fallback
+	}
+	return duration
+}
+
+// GetEnvPositiveDuration GetEnvDuration gibidir ancak sıfır veya negatif
+// süreleri de geçersiz sayar; ticker aralıkları gibi sıfırın anlamsız olduğu
+// ayarlar için kullanılır.
+func GetEnvPositiveDuration(key string, fallback time.Duration) time.Duration {
+	duration := GetEnvDuration(key, fallback)
+	if duration <= 0 {
+		log.Printf("Invalid duration for %s: must be positive, using %s", key, fallback)
+		return fallback
+	}
+	return duration
+}
+
+// GetEnvInt ortam değişkenini tam sayı olarak okur; tanımlı değilse veya
+// geçersizse varsayılan değeri döner.
+func GetEnvInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid integer for %s: %v, using %d", key, err, fallback)
+		return fallback
+	}
+	return number
+}
+
+// GetEnvList virgülle ayrılmış ortam değişkenini boşlukları kırpılmış ve boş
+// öğeleri atılmış bir liste olarak okur; tanımlı değilse nil döner.
+func GetEnvList(key string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnyVersion If-Match: * ile istemcinin sürümden bağımsız yazmak istediğini belirtir.
+const AnyVersion = -1
+
+var (
+	ErrVersionRequired = errors.New("If-Match header or version field is required")
+	ErrInvalidIfMatch  = errors.New("If-Match must be a single ETag returned by the API")
+)
+
+// VersionETag kaydın sürümünden güçlü bir ETag üretir.
+func VersionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// SetVersionETag yanıta kaydın sürümünü ETag olarak ekler.
+func SetVersionETag(c *gin.Context, version int) {
+	c.Header("ETag", VersionETag(version))
+}
+
+// ExpectedVersion istemcinin değiştirmek istediği sürümü If-Match başlığından,
+// yoksa gövdedeki version alanından okur. İkisi de yoksa ErrVersionRequired döner.
+func ExpectedVersion(c *gin.Context, bodyVersion *int) (int, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		if bodyVersion == nil {
+			return 0, ErrVersionRequired
+		}
+		return *bodyVersion, nil
+	}
+	if header == "*" {
+		return AnyVersion, nil
+	}
+
+	// Sıkıştırma yapan vekil sunucular ETag'i zayıflatabildiğinden W/ öneki yok sayılır
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, ErrInvalidIfMatch
+	}
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 1 {
+		return 0, ErrInvalidIfMatch
+	}
+	return version, nil
+}
+
+// NotModified ETag başlığını yazar ve If-None-Match başlığındaki etiketlerden
+// biri eşleşiyorsa yanıtı 304 olarak bitirir.
+func NotModified(c *gin.Context, version int) bool {
+	etag := VersionETag(version)
+	c.Header("ETag", etag)
+
+	header := c.GetHeader("If-None-Match")
+	if header == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
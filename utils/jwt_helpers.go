@@ -1,20 +1,96 @@
 package utils
 
 import (
+	"errors"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/google/uuid"
 )
 
-var jwtSecret = []byte("your_secret_key")
+const (
+	accessTokenType     = "access"
+	mfaPendingTokenType = "mfa_pending"
+)
+
+// AccessTokenTTL access token'ların geçerlilik süresidir.
+func AccessTokenTTL() time.Duration {
+	return GetEnvDuration("ACCESS_TOKEN_TTL", 15*time.Minute)
+}
 
-func GenerateJWT(userID uuid.UUID) (string, error) {
+// RefreshTokenTTL refresh token'ların geçerlilik süresidir.
+func RefreshTokenTTL() time.Duration {
+	return GetEnvDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour)
+}
+
+// GenerateJWT verilen oturum için kısa ömürlü bir access token üretir.
+func GenerateJWT(userID uuid.UUID, sessionID uuid.UUID) (string, error) {
+	now := time.Now()
 	claims := jwt.MapClaims{
 		"user_id": userID,
-		"exp":     time.Now().Add(time.Hour * 24).Unix(),
+		"sid":     sessionID,
+		"typ":     accessTokenType,
+		"jti":     uuid.New(),
+		"iat":     now.Unix(),
+		"exp":     now.Add(AccessTokenTTL()).Unix(),
+	}
+
+	return Keys.Sign(claims)
+}
+
+// ParseJWT access token'ı doğrular ve kullanıcı ile oturum kimliklerini döner.
+func ParseJWT(tokenString string) (userID uuid.UUID, sessionID uuid.UUID, err error) {
+	token, err := jwt.Parse(tokenString, Keys.Keyfunc)
+	if err != nil || !token.Valid {
+		return uuid.Nil, uuid.Nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["typ"] != accessTokenType {
+		return uuid.Nil, uuid.Nil, errors.New("invalid token claims")
+	}
+
+	userIDStr, _ := claims["user_id"].(string)
+	if userID, err = uuid.Parse(userIDStr); err != nil {
+		return uuid.Nil, uuid.Nil, errors.New("invalid user ID")
+	}
+
+	sessionIDStr, _ := claims["sid"].(string)
+	if sessionID, err = uuid.Parse(sessionIDStr); err != nil {
+		return uuid.Nil, uuid.Nil, errors.New("invalid session ID")
+	}
+
+	return userID, sessionID, nil
+}
+
+// GenerateMFAToken şifresi doğrulanmış ancak ikinci adımı tamamlamamış
+// kullanıcı için kısa ömürlü bir "mfa pending" token üretir. Bu token
+// AuthMiddleware tarafından kabul edilmez.
+func GenerateMFAToken(userID uuid.UUID) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"typ":     mfaPendingTokenType,
+		"jti":     uuid.New(),
+		"iat":     now.Unix(),
+		"exp":     now.Add(5 * time.Minute).Unix(),
+	}
+
+	return Keys.Sign(claims)
+}
+
+// ParseMFAToken "mfa pending" token'ını doğrular ve kullanıcı kimliğini döner.
+func ParseMFAToken(tokenString string) (uuid.UUID, error) {
+	token, err := jwt.Parse(tokenString, Keys.Keyfunc)
+	if err != nil || !token.Valid {
+		return uuid.Nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["typ"] != mfaPendingTokenType {
+		return uuid.Nil, errors.New("invalid token claims")
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	userIDStr, _ := claims["user_id"].(string)
+	return uuid.Parse(userIDStr)
 }
@@ -0,0 +1,306 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// SigningKey token imzalamak veya doğrulamak için kullanılan tek bir anahtardır.
+// Yalnızca açık anahtarı bilinen (emekliye ayrılmış) anahtarlar imza atamaz,
+// rotasyon süresince eski token'ları doğrulamak için tutulur.
+type SigningKey struct {
+	ID        string
+	Method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// CanSign anahtarın özel kısmının yüklü olup olmadığını döner.
+func (k *SigningKey) CanSign() bool {
+	return k.signKey != nil
+}
+
+// KeyManager imzalama anahtarlarını kid ile indeksleyerek yönetir.
+type KeyManager struct {
+	mu       sync.RWMutex
+	activeID string
+	keys     map[string]*SigningKey
+}
+
+// JWK RFC 7517 formatında tek bir açık anahtardır.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSet /.well-known/jwks.json üzerinden yayınlanan anahtar kümesidir.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Keys uygulama genelinde kullanılan anahtar yöneticisidir.
+var Keys = &KeyManager{keys: map[string]*SigningKey{}}
+
+// InitKeyManager anahtarları ortam değişkenlerinden yükler.
+//
+//   - JWT_KEYS_DIR: "<kid>.pem" dosyalarını içeren dizin (RSA veya Ed25519).
+//     Özel anahtarlar imza ve doğrulama, açık anahtarlar yalnızca doğrulama içindir.
+//   - JWT_ACTIVE_KID: imzalamada kullanılacak anahtar; boşsa alfabetik olarak
+//     son özel anahtar seçilir.
+//   - JWT_SECRET / JWT_PREVIOUS_SECRETS: JWT_KEYS_DIR yoksa HS256 için paylaşılan
+//     gizli anahtar ve rotasyon süresince hâlâ kabul edilen eski anahtarlar.
+//
+// Hiçbiri tanımlı değilse süreç ömrü boyunca geçerli geçici bir Ed25519 anahtarı üretilir.
+func InitKeyManager() error {
+	return Keys.Reload()
+}
+
+// Reload anahtarları yeniden yükler; hata durumunda mevcut anahtarlar korunur.
+func (m *KeyManager) Reload() error {
+	keys, activeID, err := loadKeysFromEnv()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.keys = keys
+	m.activeID = activeID
+	return nil
+}
+
+// Sign claim'leri aktif anahtarla imzalar ve başlığa kid ekler.
+func (m *KeyManager) Sign(claims jwt.Claims) (string, error) {
+	m.mu.RLock()
+	key, ok := m.keys[m.activeID]
+	m.mu.RUnlock()
+	if !ok || !key.CanSign() {
+		return "", errors.New("no active signing key")
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.signKey)
+}
+
+// Keyfunc jwt.Parse için token başlığındaki kid'e göre doğrulama anahtarını bulur.
+func (m *KeyManager) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	m.mu.RLock()
+	key, ok := m.keys[kid]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.verifyKey, nil
+}
+
+// JWKS asimetrik anahtarların açık kısımlarını döner. HS256 anahtarları
+// paylaşılamayacağı için yayınlanmaz.
+func (m *KeyManager) JWKS() JWKSet {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	set := JWKSet{Keys: []JWK{}}
+	for _, key := range m.keys {
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, JWK{
+				Kty: "RSA",
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				Kid: key.ID,
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			set.Keys = append(set.Keys, JWK{
+				Kty: "OKP",
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				Kid: key.ID,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+	return set
+}
+
+func loadKeysFromEnv() (map[string]*SigningKey, string, error) {
+	keys := map[string]*SigningKey{}
+
+	if dir := os.Getenv("JWT_KEYS_DIR"); dir != "" {
+		paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+		if err != nil {
+			return nil, "", err
+		}
+
+		var signable []string
+		for _, path := range paths {
+			kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+			key, err := loadPEMKey(kid, path)
+			if err != nil {
+				return nil, "", fmt.Errorf("could not load key %s: %w", path, err)
+			}
+			keys[kid] = key
+			if key.CanSign() {
+				signable = append(signable, kid)
+			}
+		}
+
+		activeID := os.Getenv("JWT_ACTIVE_KID")
+		if activeID == "" && len(signable) > 0 {
+			sort.Strings(signable)
+			activeID = signable[len(signable)-1]
+		}
+		if key, ok := keys[activeID]; !ok || !key.CanSign() {
+			return nil, "", fmt.Errorf("no private key found for active kid %q in %s", activeID, dir)
+		}
+		return keys, activeID, nil
+	}
+
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		active := hmacKey(secret)
+		keys[active.ID] = active
+		for _, previous := range strings.Split(os.Getenv("JWT_PREVIOUS_SECRETS"), ",") {
+			if previous = strings.TrimSpace(previous); previous != "" {
+				key := hmacKey(previous)
+				keys[key.ID] = key
+			}
+		}
+		return keys, active.ID, nil
+	}
+
+	log.Println("JWT_KEYS_DIR and JWT_SECRET are not set, using an ephemeral Ed25519 key; tokens will not survive a restart")
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, "", err
+	}
+	key := &SigningKey{ID: "ephemeral", Method: SigningMethodEdDSA, signKey: priv, verifyKey: pub}
+	keys[key.ID] = key
+	return keys, key.ID, nil
+}
+
+func hmacKey(secret string) *SigningKey {
+	sum := sha256.Sum256([]byte(secret))
+	return &SigningKey{
+		ID:        "hs-" + hex.EncodeToString(sum[:4]),
+		Method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+}
+
+func loadPEMKey(kid, path string) (*SigningKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodRS256, signKey: k, verifyKey: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodRS256, verifyKey: k}, nil
+	case ed25519.PrivateKey:
+		return &SigningKey{ID: kid, Method: SigningMethodEdDSA, signKey: k, verifyKey: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &SigningKey{ID: kid, Method: SigningMethodEdDSA, verifyKey: k}, nil
+	default:
+		return nil, errors.New("only RSA and Ed25519 keys are supported")
+	}
+}
+
+// signingMethodEdDSA jwt-go v3'te bulunmayan Ed25519 (RFC 8037) imza yöntemidir.
+type signingMethodEdDSA struct{}
+
+// SigningMethodEdDSA "EdDSA" alg değeriyle kayıtlı Ed25519 imza yöntemidir.
+var SigningMethodEdDSA = &signingMethodEdDSA{}
+
+func init() {
+	jwt.RegisterSigningMethod(SigningMethodEdDSA.Alg(), func() jwt.SigningMethod {
+		return SigningMethodEdDSA
+	})
+}
+
+func (m *signingMethodEdDSA) Alg() string {
+	return "EdDSA"
+}
+
+func (m *signingMethodEdDSA) Verify(signingString, signature string, key interface{}) error {
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return jwt.ErrInvalidKeyType
+	}
+
+	sig, err := jwt.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(publicKey, []byte(signingString), sig) {
+		return jwt.ErrSignatureInvalid
+	}
+	return nil
+}
+
+func (m *signingMethodEdDSA) Sign(signingString string, key interface{}) (string, error) {
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+	return jwt.EncodeSegment(ed25519.Sign(privateKey, []byte(signingString))), nil
+}
@@ -0,0 +1,226 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// SortKey listelemenin sıralandığı SQL ifadesidir. Eşit değerler ID'ye göre
+// aynı yönde sıralanır; böylece cursor ile sayfalama kararlı olur.
+type SortKey struct {
+	Expr     string // ör. "posts.created_at" veya bir alt sorgu
+	Desc     bool
+	IsTime   bool // Cursor değeri zaman olarak çözümlenir
+	IsNumber bool // Cursor değeri tam sayı olarak çözümlenir; ikisi de değilse metindir
+}
+
+// Pagination ?page=&limit= veya ?cursor=&limit= parametrelerinden okunur.
+// Cursor gönderilmişse sayfa numarası yok sayılır.
+type Pagination struct {
+	Page   int
+	Limit  int
+	cursor *pageCursor
+}
+
+type pageCursor struct {
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+// PageMeta sayfalı yanıtların "meta" alanıdır.
+type PageMeta struct {
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+	Page       int    `json:"page,omitempty"`        // Cursor ile sayfalamada boştur
+	TotalPages int    `json:"total_pages,omitempty"` // Cursor ile sayfalamada boştur
+	NextCursor string `json:"next_cursor,omitempty"`
+	Next       string `json:"next,omitempty"` // Sonraki sayfanın bağlantısı
+}
+
+// ParsePagination istekteki sayfalama parametrelerini doğrular.
+func ParsePagination(c *gin.Context) (Pagination, error) {
+	pagination := Pagination{Page: 1, Limit: DefaultPageLimit}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return pagination, errors.New("limit must be a positive integer")
+		}
+		pagination.Limit = int(math.Min(float64(limit), MaxPageLimit))
+	}
+
+	if value := c.Query("cursor"); value != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil {
+			return pagination, ErrInvalidCursor
+		}
+		var cursor pageCursor
+		if err := json.Unmarshal(raw, &cursor); err != nil {
+			return pagination, ErrInvalidCursor
+		}
+		pagination.cursor = &cursor
+		return pagination, nil
+	}
+
+	if value := c.Query("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil || page < 1 {
+			return pagination, errors.New("page must be a positive integer")
+		}
+		pagination.Page = page
+	}
+	return pagination, nil
+}
+
+// Apply sorguya sıralama, sayfa ve cursor koşullarını ekler. Sonraki sayfanın
+// varlığını anlamak için Limit+1 kayıt ister; sonuç Page ile kırpılmalıdır.
+func (p Pagination) Apply(query *gorm.DB, key SortKey, idColumn string) (*gorm.DB, error) {
+	direction, comparison := "ASC", ">"
+	if key.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if p.cursor != nil {
+		var value interface{} = p.cursor.Value
+		if key.IsTime {
+			parsed, err := time.Parse(time.RFC3339Nano, p.cursor.Value)
+			if err != nil {
+				return nil, ErrInvalidCursor
+			}
+			value = parsed
+		} else if key.IsNumber {
+			number, err := strconv.ParseInt(p.cursor.Value, 10, 64)
+			if err != nil {
+				return nil, ErrInvalidCursor
+			}
+			value = number
+		}
+		query = query.Where(
+			fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", key.Expr, comparison, key.Expr, idColumn, comparison),
+			value, value, p.cursor.ID,
+		)
+	} else {
+		query = query.Offset((p.Page - 1) * p.Limit)
+	}
+
+	return query.
+		Order(fmt.Sprintf("%s %s", key.Expr, direction)).
+		Order(fmt.Sprintf("%s %s", idColumn, direction)).
+		Limit(p.Limit + 1), nil
+}
+
+// HasMore Apply ile çekilen kayıt sayısından sonraki sayfanın olup olmadığını döner.
+func (p Pagination) HasMore(fetched int) bool {
+	return fetched > p.Limit
+}
+
+// Meta yanıtın meta alanını üretir. lastValue ve lastID sayfadaki son kaydın
+// sıralama değeri ve ID'sidir; sonraki sayfa yoksa dikkate alınmaz.
+func (p Pagination) Meta(c *gin.Context, total int64, hasMore bool, lastValue interface{}, lastID uint) PageMeta {
+	meta := PageMeta{Total: total, Limit: p.Limit}
+	if p.cursor == nil {
+		meta.Page = p.Page
+		meta.TotalPages = int(math.Ceil(float64(total) / float64(p.Limit)))
+	}
+	if !hasMore {
+		return meta
+	}
+
+	value := fmt.Sprint(lastValue)
+	if t, ok := lastValue.(time.Time); ok {
+		value = t.Format(time.RFC3339Nano)
+	}
+	raw, _ := json.Marshal(pageCursor{Value: value, ID: lastID})
+	meta.NextCursor = base64.RawURLEncoding.EncodeToString(raw)
+
+	query := c.Request.URL.Query()
+	if p.cursor != nil {
+		query.Set("cursor", meta.NextCursor)
+	} else {
+		query.Set("page", strconv.Itoa(p.Page+1))
+	}
+	meta.Next = c.Request.URL.Path + "?" + query.Encode()
+	return meta
+}
+
+// UsesCursor istekte cursor gönderilip gönderilmediğini döner.
+func (p Pagination) UsesCursor() bool {
+	return p.cursor != nil
+}
+
+// Offset sayfa numarasına göre atlanacak kayıt sayısıdır.
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.Limit
+}
+
+// OffsetMeta sıralaması kararlı bir sütuna dayanmayan (ör. alaka puanı)
+// listeler için yalnızca sayfa numarasıyla meta alanını üretir.
+func (p Pagination) OffsetMeta(c *gin.Context, total int64) PageMeta {
+	meta := PageMeta{
+		Total:      total,
+		Limit:      p.Limit,
+		Page:       p.Page,
+		TotalPages: int(math.Ceil(float64(total) / float64(p.Limit))),
+	}
+	if int64(p.Page*p.Limit) < total {
+		query := c.Request.URL.Query()
+		query.Set("page", strconv.Itoa(p.Page+1))
+		meta.Next = c.Request.URL.Path + "?" + query.Encode()
+	}
+	return meta
+}
+
+// Paginate filtrelenmiş sorgunun toplamını sayar, sayfayı çeker ve meta
+// bilgisini üretir. cursorOf bir kaydın sıralama değerini ve ID'sini döner.
+func Paginate[T any](c *gin.Context, p Pagination, query *gorm.DB, key SortKey, idColumn string, cursorOf func(T) (interface{}, uint)) ([]T, PageMeta, error) {
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, PageMeta{}, err
+	}
+
+	paged, err := p.Apply(query, key, idColumn)
+	if err != nil {
+		return nil, PageMeta{}, err
+	}
+
+	var items []T
+	if err := paged.Find(&items).Error; err != nil {
+		return nil, PageMeta{}, err
+	}
+
+	hasMore := p.HasMore(len(items))
+	if hasMore {
+		items = items[:p.Limit]
+	}
+
+	var lastValue interface{}
+	var lastID uint
+	if len(items) > 0 {
+		lastValue, lastID = cursorOf(items[len(items)-1])
+	}
+	return items, p.Meta(c, total, hasMore, lastValue, lastID), nil
+}
+
+// CreatePaginatedResponse CreateResponse zarfına sayfalama bilgisini ekler.
+func CreatePaginatedResponse(c *gin.Context, statusCode int, message string, data interface{}, meta PageMeta) {
+	c.JSON(statusCode, gin.H{
+		"message": message,
+		"data":    data,
+		"meta":    meta,
+	})
+}
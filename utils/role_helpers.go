@@ -12,3 +12,13 @@ func HasRole(user models.User, roleName string) bool {
 	}
 	return false
 }
+
+// RequiresMFA kullanıcının rollerinden herhangi biri iki adımlı doğrulama gerektiriyorsa true döner.
+func RequiresMFA(user models.User) bool {
+	for _, role := range user.Roles {
+		if role.RequireMFA {
+			return true
+		}
+	}
+	return false
+}
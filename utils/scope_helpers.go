@@ -0,0 +1,35 @@
+package utils
+
+import "strings"
+
+// PersonalAccessTokenPrefix personal access token'ları JWT'lerden ayırt etmeye yarar.
+const PersonalAccessTokenPrefix = "bpat_"
+
+// TokenScopeResources personal access token'lara verilebilen kaynaklardır.
+// Her kaynak "<kaynak>:read" ve "<kaynak>:write" kapsamlarına sahiptir.
+var TokenScopeResources = []string{"posts", "comments", "reactions", "categories", "user"}
+
+// IsValidScope kapsamın tanımlı bir kaynak ve erişim düzeyinden oluşup oluşmadığını kontrol eder.
+func IsValidScope(scope string) bool {
+	resource, access, ok := strings.Cut(scope, ":")
+	if !ok || (access != "read" && access != "write") {
+		return false
+	}
+	for _, known := range TokenScopeResources {
+		if resource == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopeAllows verilen kapsamların kaynağa istenen erişimi tanıyıp tanımadığını
+// döner. Yazma kapsamı okumayı da içerir.
+func ScopeAllows(granted []string, resource string, write bool) bool {
+	for _, scope := range granted {
+		if scope == resource+":write" || (!write && scope == resource+":read") {
+			return true
+		}
+	}
+	return false
+}
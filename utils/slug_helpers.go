@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
+)
+
+// MaxSlugLength URL'lerde kullanılan slug'ların en fazla uzunluğudur.
+const MaxSlugLength = 80
+
+// slugReplacements aksan işaretleri atılarak ASCII'ye indirgenemeyen harflerin
+// karşılıklarıdır. ş, ğ, ç, ö, ü gibi harfler ayrıştırma ile zaten sadeleşir.
+var slugReplacements = map[rune]string{
+	'ı': "i",
+	'ß': "ss",
+	'æ': "ae",
+	'œ': "oe",
+	'ø': "o",
+	'đ': "d",
+	'ð': "d",
+	'ł': "l",
+	'þ': "th",
+}
+
+// Transliterate küçük harfe çevrilmiş metni Unicode ayrıştırmasıyla aksan
+// işaretlerinden arındırır ve kalan Latin harflerini ASCII karşılıklarıyla değiştirir.
+func Transliterate(value string) string {
+	var builder strings.Builder
+	for _, r := range norm.NFD.String(value) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if replacement, ok := slugReplacements[r]; ok {
+			builder.WriteString(replacement)
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// Slugify metni küçük harf, rakam ve tirelerden oluşan bir slug'a çevirir.
+// Türkçe ve diğer Latin harfleri ASCII karşılıklarına dönüştürülür ("Işık Çağı"
+// → "isik-cagi"); kalan karakter grupları tek bir tire ile değiştirilir.
+func Slugify(value string) string {
+	var builder strings.Builder
+	pendingDash := false
+	for _, r := range Transliterate(strings.ToLower(value)) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if pendingDash && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			builder.WriteRune(r)
+			pendingDash = false
+			continue
+		}
+		pendingDash = true
+	}
+
+	slug := builder.String()
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+	return slug
+}
+
+// SlugWithFallback değerin slug'ını döner. Slug boşsa fallback kullanılır;
+// yalnızca rakamlardan oluşuyorsa ID'lerle karışmaması için başına fallback eklenir.
+func SlugWithFallback(value, fallback string) string {
+	slug := Slugify(value)
+	if slug == "" {
+		return fallback
+	}
+	if strings.Trim(slug, "0123456789") == "" {
+		return fallback + "-" + slug
+	}
+	return slug
+}
+
+// UniqueSlug base slug'ı model tablosunda kullanılmıyorsa olduğu gibi, aksi
+// halde sonuna sayı ekleyerek döner. excludeID güncellenen kaydın kendisidir.
+func UniqueSlug(tx *gorm.DB, model interface{}, base string, excludeID uint) (string, error) {
+	return UniqueSlugFunc(base, func(candidate string) (bool, error) {
+		var count int64
+		err := tx.Model(model).Where("slug = ? AND id <> ?", candidate, excludeID).Count(&count).Error
+		return count > 0, err
+	})
+}
+
+// UniqueSlugFunc taken false dönene kadar base slug'ın sonuna sayı ekleyerek
+// aday üretir; birden fazla tabloya bakılması gereken durumlar içindir.
+func UniqueSlugFunc(base string, taken func(candidate string) (bool, error)) (string, error) {
+	candidate := base
+	for i := 2; ; i++ {
+		inUse, err := taken(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !inUse {
+			return candidate, nil
+		}
+
+		suffix := fmt.Sprintf("-%d", i)
+		if len(base)+len(suffix) > MaxSlugLength {
+			candidate = strings.TrimRight(base[:MaxSlugLength-len(suffix)], "-") + suffix
+		} else {
+			candidate = base + suffix
+		}
+	}
+}
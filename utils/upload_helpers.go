@@ -0,0 +1,11 @@
+package utils
+
+import "os"
+
+// UploadDir kullanıcı yüklemelerinin saklandığı ve /uploads altında sunulduğu dizindir.
+func UploadDir() string {
+	if dir := os.Getenv("UPLOAD_DIR"); dir != "" {
+		return dir
+	}
+	return "uploads"
+}